@@ -472,6 +472,12 @@ func (itc *internalTabletConn) UpdateStream(ctx context.Context, target *querypb
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// UpdateStreamAck is part of queryservice.QueryService.
+func (itc *internalTabletConn) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	err := itc.tablet.qsc.QueryService().UpdateStreamAck(ctx, target, consumer, position)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 //
 // TabletManagerClient implementation
 //
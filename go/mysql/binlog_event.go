@@ -67,6 +67,9 @@ type BinlogEvent interface {
 	IsRand() bool
 	// IsPreviousGTIDs returns true if this event is a PREVIOUS_GTIDS_EVENT.
 	IsPreviousGTIDs() bool
+	// IsXAPrepare returns true if this is an XA_PREPARE_LOG_EVENT,
+	// which ends the binlogged part of a prepared XA transaction.
+	IsXAPrepare() bool
 
 	// RBR events.
 
@@ -81,6 +84,8 @@ type BinlogEvent interface {
 
 	// Timestamp returns the timestamp from the event header.
 	Timestamp() uint32
+	// Bytes returns the raw event data, header included.
+	Bytes() []byte
 
 	// Format returns a BinlogFormat struct based on the event data.
 	// This is only valid if IsFormatDescription() returns true.
@@ -135,6 +135,11 @@ func (ev binlogEvent) IsPreviousGTIDs() bool {
 	return ev.Type() == ePreviousGTIDsEvent
 }
 
+// IsXAPrepare implements BinlogEvent.IsXAPrepare().
+func (ev binlogEvent) IsXAPrepare() bool {
+	return ev.Type() == eXAPrepareLogEvent
+}
+
 // IsTableMap implements BinlogEvent.IsTableMap().
 func (ev binlogEvent) IsTableMap() bool {
 	return ev.Type() == eTableMapEvent
@@ -226,6 +226,17 @@ func NewXIDEvent(f BinlogFormat, s *FakeBinlogStream) BinlogEvent {
 	return NewMysql56BinlogEvent(ev)
 }
 
+// NewXAPrepareEvent returns an XA_PREPARE_LOG_EVENT. We do not use
+// the data, so keep it 0.
+func NewXAPrepareEvent(f BinlogFormat, s *FakeBinlogStream) BinlogEvent {
+	// one_phase, format_id, gtrid_length, bqual_length.
+	length := 1 + 4 + 4 + 4
+	data := make([]byte, length)
+
+	ev := s.Packetize(f, eXAPrepareLogEvent, 0, data)
+	return NewMysql56BinlogEvent(ev)
+}
+
 // NewIntVarEvent returns an IntVar event.
 func NewIntVarEvent(f BinlogFormat, s *FakeBinlogStream, typ byte, value uint64) BinlogEvent {
 	length := 9
@@ -112,6 +112,19 @@ func TestXIDEvent(t *testing.T) {
 	}
 }
 
+func TestXAPrepareEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	event := NewXAPrepareEvent(f, s)
+	if !event.IsValid() {
+		t.Fatalf("NewXAPrepareEvent().IsValid() is false")
+	}
+	if !event.IsXAPrepare() {
+		t.Fatalf("NewXAPrepareEvent().IsXAPrepare() is false")
+	}
+}
+
 func TestIntVarEvent(t *testing.T) {
 	f := NewMySQL56BinlogFormat()
 	s := NewFakeBinlogStream()
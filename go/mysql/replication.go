@@ -16,6 +16,8 @@ limitations under the License.
 
 package mysql
 
+import "strings"
+
 // This file contains the methods related to replication.
 
 // WriteComBinlogDump writes a ComBinlogDump command.
@@ -67,3 +69,10 @@ func (c *Conn) WriteComBinlogDumpGTID(serverID uint32, binlogFilename string, bi
 	}
 	return nil
 }
+
+// IsBinlogEnabled returns true if value, the value of the log_bin
+// server variable, means that binary logging is enabled. Depending on
+// how it's read, the variable is reported as "ON" or "1".
+func IsBinlogEnabled(value string) bool {
+	return strings.EqualFold(value, "ON") || value == "1"
+}
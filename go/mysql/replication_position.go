@@ -166,3 +166,18 @@ func (rp *Position) UnmarshalJSON(buf []byte) error {
 	}
 	return nil
 }
+
+// MarshalText implements encoding.TextMarshaler.
+func (rp Position) MarshalText() ([]byte, error) {
+	return []byte(EncodePosition(rp)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (rp *Position) UnmarshalText(buf []byte) error {
+	pos, err := DecodePosition(string(buf))
+	if err != nil {
+		return err
+	}
+	*rp = pos
+	return nil
+}
@@ -412,3 +412,40 @@ func TestJsonUnmarshalPositionZero(t *testing.T) {
 		t.Errorf("json.Unmarshal(%#v) = %#v, want %#v", input, got, want)
 	}
 }
+
+func TestTextMarshalPosition(t *testing.T) {
+	input := Position{GTIDSet: fakeGTID{flavor: "golf", value: "par"}}
+	want := "golf/par"
+
+	buf, err := input.MarshalText()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := string(buf); got != want {
+		t.Errorf("%#v.MarshalText() = %#v, want %#v", input, got, want)
+	}
+}
+
+func TestTextUnmarshalPosition(t *testing.T) {
+	input := "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"
+	want := MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+
+	var got Position
+	if err := got.UnmarshalText([]byte(input)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("UnmarshalText(%#v) = %#v, want %#v", input, got, want)
+	}
+}
+
+func TestTextUnmarshalPositionError(t *testing.T) {
+	input := "MySQL56/not-a-gtid-set"
+	want := "invalid MySQL 5.6 GTID set"
+
+	var got Position
+	err := got.UnmarshalText([]byte(input))
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("UnmarshalText(%#v) = %v, want error containing %#v", input, err, want)
+	}
+}
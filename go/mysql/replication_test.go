@@ -128,3 +128,22 @@ func TestComBinlogDumpGTID(t *testing.T) {
 		t.Errorf("ComBinlogDumpGTID returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
 	}
 }
+
+func TestIsBinlogEnabled(t *testing.T) {
+	testcases := []struct {
+		value string
+		want  bool
+	}{
+		{"ON", true},
+		{"on", true},
+		{"1", true},
+		{"OFF", false},
+		{"0", false},
+		{"", false},
+	}
+	for _, tcase := range testcases {
+		if got := IsBinlogEnabled(tcase.value); got != tcase.want {
+			t.Errorf("IsBinlogEnabled(%q): %v, want %v", tcase.value, got, tcase.want)
+		}
+	}
+}
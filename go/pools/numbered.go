@@ -113,6 +113,19 @@ func (nu *Numbered) GetAll() (vals []interface{}) {
 	return vals
 }
 
+// Visit calls f for every resource in the pool, along with whether
+// it's in use and when it was last returned. The pool is locked
+// while f runs, so f must not call back into the pool. Since a
+// resource can't be acquired while f runs, f may safely read the
+// state of resources that are not in use.
+func (nu *Numbered) Visit(f func(val interface{}, inUse bool, timeUsed time.Time)) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+	for _, nw := range nu.resources {
+		f(nw.val, nw.inUse, nw.timeUsed)
+	}
+}
+
 // GetOutdated returns a list of resources that are older than age, and locks them.
 // It does not return any resources that are already locked.
 func (nu *Numbered) GetOutdated(age time.Duration, purpose string) (vals []interface{}) {
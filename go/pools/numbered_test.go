@@ -17,6 +17,7 @@ limitations under the License.
 package pools
 
 import (
+	"reflect"
 	"testing"
 	"time"
 )
@@ -88,6 +89,15 @@ func TestNumbered(t *testing.T) {
 	if p.Size() != 2 {
 		t.Errorf("want 2, got %v", p.Size())
 	}
+	p.Get(1, "visit")
+	inUse := make(map[int64]bool)
+	p.Visit(func(val interface{}, used bool, timeUsed time.Time) {
+		inUse[val.(int64)] = used
+	})
+	if want := map[int64]bool{0: false, 1: true}; !reflect.DeepEqual(inUse, want) {
+		t.Errorf("Visit: %v, want %v", inUse, want)
+	}
+	p.Put(1)
 	go func() {
 		p.Unregister(0)
 		p.Unregister(1)
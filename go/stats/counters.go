@@ -103,6 +103,13 @@ func (c *Counters) Set(name string, value int64) {
 	atomic.StoreInt64(a, value)
 }
 
+// Delete removes a named counter.
+func (c *Counters) Delete(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.counts, name)
+}
+
 // Reset resets all counter values
 func (c *Counters) Reset() {
 	c.mu.Lock()
@@ -196,6 +203,25 @@ func (mc *MultiCounters) Set(names []string, value int64) {
 	mc.Counters.Set(mapKey(names), value)
 }
 
+// DeleteLabel removes all the counters which have value for label,
+// e.g. the counters of a table that was dropped.
+func (mc *MultiCounters) DeleteLabel(label, value string) {
+	i := 0
+	for i < len(mc.labels) && mc.labels[i] != label {
+		i++
+	}
+	if i == len(mc.labels) {
+		panic(fmt.Sprintf("MultiCounters: unknown label %v in DeleteLabel", label))
+	}
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	for k := range mc.counts {
+		if names := splitKey(k); len(names) == len(mc.labels) && names[i] == value {
+			delete(mc.counts, k)
+		}
+	}
+}
+
 // MultiCountersFunc is a multidimensional CountersFunc implementation
 // where names of categories are compound names made with joining
 // multiple strings with '.'.  Since the map is returned by the
@@ -234,3 +260,24 @@ func mapKey(ss []string) string {
 	}
 	return strings.Join(esc, ".")
 }
+
+// splitKey is the inverse of mapKey.
+func splitKey(key string) []string {
+	var names []string
+	var name []byte
+	for i := 0; i < len(key); i++ {
+		switch key[i] {
+		case '\\':
+			if i+1 < len(key) {
+				i++
+			}
+			name = append(name, key[i])
+		case '.':
+			names = append(names, string(name))
+			name = name[:0]
+		default:
+			name = append(name, key[i])
+		}
+	}
+	return append(names, string(name))
+}
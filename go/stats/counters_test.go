@@ -120,6 +120,49 @@ func TestMultiCountersDot(t *testing.T) {
 	}
 }
 
+func TestCountersDelete(t *testing.T) {
+	clear()
+	c := NewCounters("counterDelete", "c1", "c2")
+	c.Delete("c1")
+	c.Delete("unknown")
+	want := map[string]int64{"c2": 0}
+	if got := c.Counts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestMultiCountersDeleteLabel(t *testing.T) {
+	clear()
+	c := NewMultiCounters("mapCounterDelete", []string{"Table", "User"})
+	c.Add([]string{"t1", "u1"}, 1)
+	c.Add([]string{"t1", "u2"}, 1)
+	c.Add([]string{"t1.x", "u1"}, 1)
+	c.Add([]string{"t2", "t1"}, 1)
+	c.DeleteLabel("Table", "t1")
+	want := map[string]int64{"t1\\.x.u1": 1, "t2.t1": 1}
+	if got := c.Counts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	c.DeleteLabel("Table", "t1.x")
+	want = map[string]int64{"t2.t1": 1}
+	if got := c.Counts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestSplitKey(t *testing.T) {
+	for _, names := range [][]string{
+		{""},
+		{"a", "b"},
+		{"a.b", "c\\d", ""},
+		{"a\\.b", "."},
+	} {
+		if got := splitKey(mapKey(names)); !reflect.DeepEqual(got, names) {
+			t.Errorf("splitKey(mapKey(%q)): %q", names, got)
+		}
+	}
+}
+
 func TestCountersHook(t *testing.T) {
 	var gotname string
 	var gotv *Counters
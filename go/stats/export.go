@@ -88,6 +88,7 @@ var defaultVarGroup = varGroup{vars: make(map[string]expvar.Var)}
 // that will be called whenever a new stats variable gets
 // created. This can be used to build alternate methods
 // of exporting stats variables.
+// Only one hook can be registered per process.
 func Register(nvh NewVarHook) {
 	defaultVarGroup.register(nvh)
 }
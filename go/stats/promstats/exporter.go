@@ -0,0 +1,115 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promstats
+
+import (
+	"expvar"
+	"flag"
+	"net/http"
+	"sync"
+	"unicode"
+
+	log "github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/servenv"
+)
+
+var (
+	enableMetrics       = flag.Bool("enable_prometheus_metrics", false, "if set, serve all stats variables in the prometheus text format at /metrics")
+	prometheusNamespace = flag.String("prometheus_namespace", "vitess", "namespace prefix of the metric names served at /metrics")
+
+	initOnce sync.Once
+)
+
+func init() {
+	// Needs to happen in servenv.OnRun() instead of init because it requires flag parsing.
+	servenv.OnRun(func() {
+		if *enableMetrics {
+			Init(*prometheusNamespace)
+		}
+	})
+}
+
+// Init registers a stats hook which exports every stats variable,
+// including the ones published before the call, to the default
+// prometheus registry under namespace, and serves them in the
+// prometheus text format at /metrics.
+// Variable names are converted by MetricName.
+// Init uses the stats package's only NewVarHook: stats.Register
+// panics if it's called by anything else in the same process.
+// Calls after the first one are no-ops.
+func Init(namespace string) {
+	initOnce.Do(func() {
+		stats.Register(func(name string, v expvar.Var) {
+			publish(namespace, name, v)
+		})
+		http.Handle("/metrics", prometheus.Handler())
+	})
+}
+
+func publish(namespace, name string, v expvar.Var) {
+	coll := NewCollector(prometheus.Opts{
+		Namespace: namespace,
+		Name:      MetricName(name),
+		Help:      name,
+	}, v)
+	if coll == nil {
+		return
+	}
+	if err := prometheus.Register(coll); err != nil {
+		log.Warningf("Could not export %s to prometheus: %v", name, err)
+	}
+}
+
+// MetricName converts a stats variable name like "QueryCounts"
+// or "TableACLAllowed" into a prometheus metric name like
+// "query_counts" or "table_acl_allowed". Characters which are not
+// valid in a prometheus metric name are replaced with '_', and a
+// leading digit is prefixed with one.
+func MetricName(name string) string {
+	runes := []rune(name)
+	out := make([]rune, 0, len(runes)+4)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word on a lower-to-upper transition
+			// ("queryCounts"), or at the last capital of an
+			// acronym followed by a word ("ACLAllowed").
+			if i > 0 && (isLowerOrDigit(runes[i-1]) || unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				out = append(out, '_')
+			}
+			out = append(out, unicode.ToLower(r))
+			continue
+		}
+		if i == 0 && unicode.IsDigit(r) {
+			out = append(out, '_')
+		}
+		if !isMetricNameRune(r) {
+			r = '_'
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+func isLowerOrDigit(r rune) bool {
+	return unicode.IsLower(r) || unicode.IsDigit(r)
+}
+
+func isMetricNameRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == ':'
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promstats
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	pb "github.com/prometheus/client_model/go"
+)
+
+func TestMetricName(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{
+		{"QueryCounts", "query_counts"},
+		{"TableACLAllowed", "table_acl_allowed"},
+		{"TxSerializerWaiting", "tx_serializer_waiting"},
+		{"Mysql", "mysql"},
+		{"QPS", "qps"},
+		{"Queries2Sec", "queries2_sec"},
+		{"already_snake", "already_snake"},
+		{"with-dash.and space", "with_dash_and_space"},
+		{"1stValue", "_1st_value"},
+	}
+	for _, tc := range testcases {
+		if got := MetricName(tc.in); got != tc.out {
+			t.Errorf("MetricName(%q): %q, want %q", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestInit(t *testing.T) {
+	// Variables published before Init must be exported too.
+	before := stats.NewInt("PromExportBefore")
+	before.Set(12)
+
+	Init("vttest")
+	// Init must be idempotent, stats.Register would panic.
+	Init("vttest")
+
+	// Metric names of tabletserver variables must be stable,
+	// dashboards depend on them.
+	tabletenv.KillStats.Add("Queries", 1)
+	tabletenv.QueryStats.Add("PASS_SELECT", 3*time.Millisecond)
+	tabletenv.UserTableQueryCount.Add([]string{"t1", "user1", "Execute"}, 2)
+	tabletenv.TableaclAllowed.Add([]string{"t1", "group1", "PASS_SELECT", "user1"}, 1)
+
+	counters := stats.NewCounters("PromExportCounters")
+	counters.Add("a", 3)
+	timings := stats.NewTimings("PromExportTimings")
+	timings.Add("select", 2*time.Millisecond)
+	stats.NewString("PromExportString").Set("ignored")
+
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	response := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(response, request)
+	if response.Code != http.StatusOK {
+		t.Fatalf("/metrics: %d, want %d", response.Code, http.StatusOK)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(response.Body)
+	if err != nil {
+		t.Fatalf("/metrics is not in the prometheus text format: %v", err)
+	}
+
+	testcases := []struct {
+		name  string
+		typ   pb.MetricType
+		label string
+		value float64
+	}{
+		{"vttest_prom_export_before", pb.MetricType_GAUGE, "", 12},
+		{"vttest_prom_export_counters", pb.MetricType_GAUGE, "a", 3},
+		{"vttest_prom_export_timings", pb.MetricType_HISTOGRAM, "select", 0.002},
+		{"vttest_queries", pb.MetricType_HISTOGRAM, "PASS_SELECT", 0.003},
+		{"vttest_user_table_query_count", pb.MetricType_GAUGE, "", 2},
+		{"vttest_table_acl_allowed", pb.MetricType_GAUGE, "", 1},
+	}
+	for _, tc := range testcases {
+		family, ok := families[tc.name]
+		if !ok {
+			t.Errorf("%s not found in /metrics", tc.name)
+			continue
+		}
+		if family.GetType() != tc.typ || len(family.Metric) != 1 {
+			t.Errorf("%s: %v", tc.name, family)
+			continue
+		}
+		m := family.Metric[0]
+		var value float64
+		switch tc.typ {
+		case pb.MetricType_GAUGE:
+			value = m.GetGauge().GetValue()
+		case pb.MetricType_COUNTER:
+			value = m.GetCounter().GetValue()
+		case pb.MetricType_HISTOGRAM:
+			value = m.GetHistogram().GetSampleSum()
+		}
+		if value != tc.value {
+			t.Errorf("%s value: %v, want %v", tc.name, value, tc.value)
+		}
+		if tc.label != "" && (len(m.Label) != 1 || m.Label[0].GetValue() != tc.label) {
+			t.Errorf("%s labels: %v, want %s", tc.name, m.Label, tc.label)
+		}
+	}
+	// Counters are exported as gauges: some of them, like
+	// TxSerializerWaiting, go down.
+	kills, ok := families["vttest_kills"]
+	if !ok || kills.GetType() != pb.MetricType_GAUGE {
+		t.Errorf("vttest_kills: %v, want a gauge", kills)
+	}
+	if _, ok := families["vttest_prom_export_string"]; ok {
+		t.Error("string variable must not be exported")
+	}
+}
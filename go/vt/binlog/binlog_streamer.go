@@ -18,9 +18,13 @@ package binlog
 
 import (
 	"bytes"
+	"encoding/binary"
+	"flag"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"strings"
+	"time"
 
 	log "github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -29,6 +33,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/schema"
 
@@ -39,6 +44,16 @@ import (
 var (
 	binlogStreamerErrors = stats.NewCounters("BinlogStreamerErrors")
 
+	// binlogStreamerGTIDs counts GTIDs that were already in the
+	// position ("Duplicate"), that skip over some transactions
+	// of their server or domain ("Gap"), or that are of a different
+	// flavor ("FlavorMismatch").
+	binlogStreamerGTIDs = stats.NewCounters("BinlogStreamerGTIDAnomalies")
+	gtidAnomalyLog      = logutil.NewThrottledLogger("BinlogStreamerGTIDAnomaly", 1*time.Minute)
+
+	verifyChecksums = flag.Bool("binlog_streamer_verify_checksums", false, "If true, the binlog streamer verifies the CRC32 checksum of each event, and stops with an error if it doesn't match. This has a CPU cost.")
+	strictGTIDOrder = flag.Bool("binlog_streamer_strict_gtid_order", false, "If true, the binlog streamer stops with an error when a GTID is not contiguous with the current position, instead of only logging it.")
+
 	// ErrClientEOF is returned by Streamer if the stream ended because the
 	// consumer of the stream indicated it doesn't want any more events.
 	ErrClientEOF = fmt.Errorf("binlog stream consumer ended the reply stream")
@@ -61,6 +76,10 @@ var (
 		"truncate": binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 		"rename":   binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 		"set":      binlogdatapb.BinlogTransaction_Statement_BL_SET,
+		// Account management statements are treated like the
+		// CREATE/DROP/RENAME USER statements already covered above.
+		"grant":  binlogdatapb.BinlogTransaction_Statement_BL_DDL,
+		"revoke": binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 	}
 )
 
@@ -73,6 +92,12 @@ type FullBinlogStatement struct {
 	KeyspaceID []byte
 	PKNames    []*querypb.Field
 	PKValues   []sqltypes.Value
+
+	// ColumnNames and ColumnValues are the non-PK columns written
+	// by an RBR insert, or changed by an RBR update, and their new
+	// values. Like the PK, they're only extracted if requested.
+	ColumnNames  []*querypb.Field
+	ColumnValues []sqltypes.Value
 }
 
 // sendTransactionFunc is used to send binlog events.
@@ -87,6 +112,121 @@ func getStatementCategory(sql string) binlogdatapb.BinlogTransaction_Statement_C
 	return statementPrefixes[strings.ToLower(sql)]
 }
 
+// isSavepointStatement returns true for SAVEPOINT, RELEASE SAVEPOINT
+// and ROLLBACK [WORK] TO [SAVEPOINT]. They don't start or end a
+// transaction, so ROLLBACK TO must not be mistaken for a ROLLBACK.
+// The statement alone decides: it doesn't matter whether the stream
+// is inside a transaction.
+func isSavepointStatement(sql string) bool {
+	words := strings.Fields(strings.ToLower(sql))
+	if len(words) < 2 {
+		return false
+	}
+	switch words[0] {
+	case "savepoint", "release":
+		return true
+	case "rollback":
+		return words[1] == "to" || len(words) > 2 && words[1] == "work" && words[2] == "to"
+	}
+	return false
+}
+
+// parseXAStatement splits an XA statement into its lowercase verb
+// (start, begin, end, prepare, commit, rollback or recover) and the
+// xid it applies to. XA COMMIT ... ONE PHASE returns the "commit" verb
+// with onePhase set. ok is false if sql is not an XA statement.
+func parseXAStatement(sql string) (verb, xid string, onePhase, ok bool) {
+	words := strings.Fields(sql)
+	if len(words) < 2 || !strings.EqualFold(words[0], "xa") {
+		return "", "", false, false
+	}
+	verb = strings.ToLower(words[1])
+	switch verb {
+	case "start", "begin", "end", "prepare", "commit", "rollback", "recover":
+	default:
+		return "", "", false, false
+	}
+	args := words[2:]
+	// Drop the trailing options, which are not part of the xid.
+	for len(args) > 0 {
+		last := strings.ToLower(args[len(args)-1])
+		switch {
+		case verb == "commit" && last == "phase" && len(args) > 1 && strings.EqualFold(args[len(args)-2], "one"):
+			onePhase = true
+			args = args[:len(args)-2]
+			continue
+		case (verb == "start" || verb == "begin") && (last == "join" || last == "resume"),
+			verb == "end" && (last == "suspend" || last == "migrate" || last == "for"):
+			args = args[:len(args)-1]
+			continue
+		}
+		break
+	}
+	return verb, strings.Join(args, " "), onePhase, true
+}
+
+// checkGTID counts and logs a GTID that is already part of pos,
+// or that is not contiguous with it. A gap is only an error
+// if -binlog_streamer_strict_gtid_order is set. A GTID of a different
+// flavor than pos is always an error, since the GTIDSet would
+// silently ignore it.
+func checkGTID(pos mysql.Position, gtid mysql.GTID) error {
+	if pos.IsZero() || gtid == nil {
+		return nil
+	}
+	switch {
+	case gtid.Flavor() != pos.GTIDSet.Flavor():
+		binlogStreamerGTIDs.Add("FlavorMismatch", 1)
+		return fmt.Errorf("GTID %v has flavor %v, but the current position %v has flavor %v", gtid, gtid.Flavor(), pos, pos.GTIDSet.Flavor())
+	case pos.GTIDSet.ContainsGTID(gtid):
+		binlogStreamerGTIDs.Add("Duplicate", 1)
+		gtidAnomalyLog.Warningf("binlog stream replayed GTID %v, which is already in the current position %v", gtid, pos)
+	case gtidGap(pos.GTIDSet, gtid):
+		binlogStreamerGTIDs.Add("Gap", 1)
+		if *strictGTIDOrder {
+			return fmt.Errorf("GTID %v is not contiguous with the current position %v", gtid, pos)
+		}
+		gtidAnomalyLog.Warningf("binlog stream skipped to GTID %v, which is not contiguous with the current position %v", gtid, pos)
+	}
+	return nil
+}
+
+// gtidGap returns true if set already has transactions from the
+// server (MySQL 5.6) or domain (MariaDB) of gtid, but not the one
+// right before it.
+func gtidGap(set mysql.GTIDSet, gtid mysql.GTID) bool {
+	switch gtid := gtid.(type) {
+	case mysql.Mysql56GTID:
+		set56, ok := set.(mysql.Mysql56GTIDSet)
+		if !ok || len(set56[gtid.Server]) == 0 {
+			return false
+		}
+		return !set56.ContainsGTID(mysql.Mysql56GTID{Server: gtid.Server, Sequence: gtid.Sequence - 1})
+	case mysql.MariadbGTID:
+		cur, ok := set.(mysql.MariadbGTID)
+		if !ok || cur.Domain != gtid.Domain {
+			return false
+		}
+		return gtid.Sequence > cur.Sequence+1
+	}
+	return false
+}
+
+// verifyChecksum returns an error if checksum is not the CRC32 of
+// the event. ev is the event returned by StripChecksum. The error
+// contains the start of the event, hex encoded.
+func verifyChecksum(ev mysql.BinlogEvent, checksum []byte) error {
+	data := ev.Bytes()
+	want := binary.LittleEndian.Uint32(checksum)
+	if got := crc32.ChecksumIEEE(data); got != want {
+		if len(data) > 256 {
+			data = data[:256]
+		}
+		return fmt.Errorf("binlog event checksum mismatch: got %08x, want %08x, event data: %x", got, want, data)
+	}
+	return nil
+}
+
 // tableCacheEntry contains everything we know about a table.
 // It is created when we get a TableMap event.
 type tableCacheEntry struct {
@@ -126,6 +266,10 @@ type tableCacheEntry struct {
 	// This array is built this way so when we extract the columns
 	// in a row, we can just save them in the PK array easily.
 	pkIndexes []int
+
+	// columnFields contains a field for every column of the table.
+	// It's used to build the ColumnNames of a statement.
+	columnFields []*querypb.Field
 }
 
 // Streamer streams binlog events from MySQL by connecting as a slave.
@@ -253,6 +397,14 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 	// tableMaps is indexed by tableID.
 	tableMaps := make(map[uint64]*tableCacheEntry)
 
+	// A prepared XA transaction is binlogged up to its XA_PREPARE event,
+	// and its XA COMMIT or XA ROLLBACK comes later, in a transaction of
+	// its own. xid is the XA transaction that's being read, and prepared
+	// holds the statements of the prepared ones, indexed by xid, until
+	// they're committed or rolled back.
+	var xid string
+	prepared := make(map[string][]FullBinlogStatement)
+
 	// A begin can be triggered either by a BEGIN query, or by a GTID_EVENT.
 	begin := func() {
 		if statements != nil {
@@ -328,11 +480,19 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			return pos, fmt.Errorf("got a real event before FORMAT_DESCRIPTION_EVENT: %#v", ev)
 		}
 
-		// Strip the checksum, if any. We don't actually verify the checksum, so discard it.
-		ev, _, err = ev.StripChecksum(format)
+		// Strip the checksum, if any. It is only verified if
+		// -binlog_streamer_verify_checksums is set.
+		var checksum []byte
+		ev, checksum, err = ev.StripChecksum(format)
 		if err != nil {
 			return pos, fmt.Errorf("can't strip checksum from binlog event: %v, event data: %#v", err, ev)
 		}
+		if *verifyChecksums && checksum != nil {
+			if err := verifyChecksum(ev, checksum); err != nil {
+				binlogStreamerErrors.Add("Checksum", 1)
+				return pos, err
+			}
+		}
 
 		switch {
 		case ev.IsGTID(): // GTID_EVENT: update current GTID, maybe BEGIN.
@@ -341,6 +501,9 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			if err != nil {
 				return pos, fmt.Errorf("can't get GTID from binlog event: %v, event data: %#v", err, ev)
 			}
+			if err := checkGTID(pos, gtid); err != nil {
+				return pos, err
+			}
 			pos = mysql.AppendGTID(pos, gtid)
 			if hasBegin {
 				begin()
@@ -349,6 +512,14 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			if err = commit(ev.Timestamp()); err != nil {
 				return pos, err
 			}
+		case ev.IsXAPrepare(): // XA_PREPARE_LOG_EVENT
+			// Hold the statements until the outcome of the
+			// transaction is known. Nothing is sent, so the
+			// position is only reported with the next transaction.
+			prepared[xid] = statements
+			xid = ""
+			statements = nil
+			autocommit = true
 		case ev.IsIntVar(): // INTVAR_EVENT
 			typ, value, err := ev.IntVar(format)
 			if err != nil {
@@ -377,6 +548,48 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			if err != nil {
 				return pos, fmt.Errorf("can't get query from binlog event: %v, event data: %#v", err, ev)
 			}
+			if isSavepointStatement(q.SQL) {
+				// Statements rolled back to a savepoint on transactional
+				// tables are never written to the binlog, so there is
+				// nothing to undo and the transaction goes on.
+				continue
+			}
+			if verb, stmtXID, onePhase, ok := parseXAStatement(q.SQL); ok {
+				switch verb {
+				case "start", "begin":
+					// A MariaDB GTID_EVENT has already started it.
+					if statements == nil {
+						begin()
+					}
+					xid = stmtXID
+				case "commit":
+					if !onePhase {
+						// The statements were held at XA PREPARE.
+						held, ok := prepared[stmtXID]
+						if !ok {
+							log.Errorf("XA COMMIT of unknown transaction %v: it was prepared before the start of the stream", stmtXID)
+							binlogStreamerErrors.Add("ParseEvents", 1)
+						}
+						delete(prepared, stmtXID)
+						statements = held
+					}
+					if err = commit(ev.Timestamp()); err != nil {
+						return pos, err
+					}
+				case "rollback":
+					// Like for ROLLBACK, send an empty transaction
+					// so the client can update its position.
+					delete(prepared, stmtXID)
+					statements = nil
+					if err = commit(ev.Timestamp()); err != nil {
+						return pos, err
+					}
+				}
+				// XA END doesn't end the transaction, XA PREPARE is
+				// binlogged as an XA_PREPARE_LOG_EVENT, and XA RECOVER
+				// is not binlogged.
+				continue
+			}
 			switch cat := getStatementCategory(q.SQL); cat {
 			case binlogdatapb.BinlogTransaction_Statement_BL_BEGIN:
 				begin()
@@ -483,14 +696,18 @@ func (bls *Streamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 					// Put -1 as default in here.
 					tce.pkIndexes[i] = -1
 				}
+				tce.columnFields = make([]*querypb.Field, len(tce.ti.Columns))
+				for i, c := range tce.ti.Columns {
+					tce.columnFields[i] = &querypb.Field{
+						Name: c.Name.String(),
+						Type: c.Type,
+					}
+				}
 				for i, c := range tce.ti.PKColumns {
 					// Patch in every PK column index.
 					tce.pkIndexes[c] = i
 					// Fill in pknames
-					tce.pkNames[i] = &querypb.Field{
-						Name: tce.ti.Columns[c].Name.String(),
-						Type: tce.ti.Columns[c].Type,
-					}
+					tce.pkNames[i] = tce.columnFields[c]
 				}
 			}
 		case ev.IsWriteRows():
@@ -592,11 +809,16 @@ func (bls *Streamer) appendInserts(statements []FullBinlogStatement, tce *tableC
 		sql := sqlparser.NewTrackedBuffer(nil)
 		sql.Myprintf("INSERT INTO %v SET ", sqlparser.NewTableIdent(tce.tm.Name))
 
-		keyspaceIDCell, pkValues, err := writeValuesAsSQL(sql, tce, rows, i, tce.pkNames != nil)
+		var after []sqltypes.Value
+		if tce.pkNames != nil {
+			after = make([]sqltypes.Value, len(tce.ti.Columns))
+		}
+		keyspaceIDCell, pkValues, err := writeValuesAsSQL(sql, tce, rows, i, tce.pkNames != nil, after)
 		if err != nil {
 			log.Warningf("writeValuesAsSQL(%v) failed: %v", i, err)
 			continue
 		}
+		columnNames, columnValues := changedColumns(tce, rows, after, nil)
 
 		// Fill in keyspace id if needed.
 		var ksid []byte
@@ -613,11 +835,13 @@ func (bls *Streamer) appendInserts(statements []FullBinlogStatement, tce *tableC
 			Sql:      sql.Bytes(),
 		}
 		statements = append(statements, FullBinlogStatement{
-			Statement:  statement,
-			Table:      tce.tm.Name,
-			KeyspaceID: ksid,
-			PKNames:    tce.pkNames,
-			PKValues:   pkValues,
+			Statement:    statement,
+			Table:        tce.tm.Name,
+			KeyspaceID:   ksid,
+			PKNames:      tce.pkNames,
+			PKValues:     pkValues,
+			ColumnNames:  columnNames,
+			ColumnValues: columnValues,
 		})
 	}
 	return statements
@@ -628,7 +852,12 @@ func (bls *Streamer) appendUpdates(statements []FullBinlogStatement, tce *tableC
 		sql := sqlparser.NewTrackedBuffer(nil)
 		sql.Myprintf("UPDATE %v SET ", sqlparser.NewTableIdent(tce.tm.Name))
 
-		keyspaceIDCell, pkValues, err := writeValuesAsSQL(sql, tce, rows, i, tce.pkNames != nil)
+		var after, before []sqltypes.Value
+		if tce.pkNames != nil {
+			after = make([]sqltypes.Value, len(tce.ti.Columns))
+			before = make([]sqltypes.Value, len(tce.ti.Columns))
+		}
+		keyspaceIDCell, pkValues, err := writeValuesAsSQL(sql, tce, rows, i, tce.pkNames != nil, after)
 		if err != nil {
 			log.Warningf("writeValuesAsSQL(%v) failed: %v", i, err)
 			continue
@@ -636,10 +865,11 @@ func (bls *Streamer) appendUpdates(statements []FullBinlogStatement, tce *tableC
 
 		sql.WriteString(" WHERE ")
 
-		if _, _, err := writeIdentifiersAsSQL(sql, tce, rows, i, false); err != nil {
+		if _, _, err := writeIdentifiersAsSQL(sql, tce, rows, i, false, before); err != nil {
 			log.Warningf("writeIdentifiesAsSQL(%v) failed: %v", i, err)
 			continue
 		}
+		columnNames, columnValues := changedColumns(tce, rows, after, before)
 
 		// Fill in keyspace id if needed.
 		var ksid []byte
@@ -656,11 +886,13 @@ func (bls *Streamer) appendUpdates(statements []FullBinlogStatement, tce *tableC
 			Sql:      sql.Bytes(),
 		}
 		statements = append(statements, FullBinlogStatement{
-			Statement:  update,
-			Table:      tce.tm.Name,
-			KeyspaceID: ksid,
-			PKNames:    tce.pkNames,
-			PKValues:   pkValues,
+			Statement:    update,
+			Table:        tce.tm.Name,
+			KeyspaceID:   ksid,
+			PKNames:      tce.pkNames,
+			PKValues:     pkValues,
+			ColumnNames:  columnNames,
+			ColumnValues: columnValues,
 		})
 	}
 	return statements
@@ -671,7 +903,7 @@ func (bls *Streamer) appendDeletes(statements []FullBinlogStatement, tce *tableC
 		sql := sqlparser.NewTrackedBuffer(nil)
 		sql.Myprintf("DELETE FROM %v WHERE ", sqlparser.NewTableIdent(tce.tm.Name))
 
-		keyspaceIDCell, pkValues, err := writeIdentifiersAsSQL(sql, tce, rows, i, tce.pkNames != nil)
+		keyspaceIDCell, pkValues, err := writeIdentifiersAsSQL(sql, tce, rows, i, tce.pkNames != nil, nil)
 		if err != nil {
 			log.Warningf("writeIdentifiesAsSQL(%v) failed: %v", i, err)
 			continue
@@ -704,8 +936,9 @@ func (bls *Streamer) appendDeletes(statements []FullBinlogStatement, tce *tableC
 
 // writeValuesAsSQL is a helper method to print the values as SQL in the
 // provided bytes.Buffer. It also returns the value for the keyspaceIDColumn,
-// and the array of values for the PK, if necessary.
-func writeValuesAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *mysql.Rows, rowIndex int, getPK bool) (sqltypes.Value, []sqltypes.Value, error) {
+// and the array of values for the PK, if necessary. If values is not nil,
+// the value of every column is also saved at the column's index in it.
+func writeValuesAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *mysql.Rows, rowIndex int, getPK bool, values []sqltypes.Value) (sqltypes.Value, []sqltypes.Value, error) {
 	valueIndex := 0
 	data := rs.Rows[rowIndex].Data
 	pos := 0
@@ -756,6 +989,9 @@ func writeValuesAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *my
 				pkValues[tce.pkIndexes[c]] = value
 			}
 		}
+		if values != nil {
+			values[c] = value
+		}
 		pos += l
 		valueIndex++
 	}
@@ -765,8 +1001,9 @@ func writeValuesAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *my
 
 // writeIdentifiersAsSQL is a helper method to print the identifies as SQL in the
 // provided bytes.Buffer. It also returns the value for the keyspaceIDColumn,
-// and the array of values for the PK, if necessary.
-func writeIdentifiersAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *mysql.Rows, rowIndex int, getPK bool) (sqltypes.Value, []sqltypes.Value, error) {
+// and the array of values for the PK, if necessary. If values is not nil,
+// the value of every column is also saved at the column's index in it.
+func writeIdentifiersAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, rs *mysql.Rows, rowIndex int, getPK bool, values []sqltypes.Value) (sqltypes.Value, []sqltypes.Value, error) {
 	valueIndex := 0
 	data := rs.Rows[rowIndex].Identify
 	pos := 0
@@ -817,9 +1054,35 @@ func writeIdentifiersAsSQL(sql *sqlparser.TrackedBuffer, tce *tableCacheEntry, r
 				pkValues[tce.pkIndexes[c]] = value
 			}
 		}
+		if values != nil {
+			values[c] = value
+		}
 		pos += l
 		valueIndex++
 	}
 
 	return keyspaceIDCell, pkValues, nil
 }
+
+// changedColumns returns the fields and values of the non-PK columns
+// in the after image of a row which are not in its before image, or
+// have a different value there. before is nil for inserts. NULL values
+// are left as zero values in both images. It returns nil if after is nil.
+func changedColumns(tce *tableCacheEntry, rs *mysql.Rows, after, before []sqltypes.Value) ([]*querypb.Field, []sqltypes.Value) {
+	if after == nil {
+		return nil, nil
+	}
+	var names []*querypb.Field
+	var values []sqltypes.Value
+	for c := 0; c < rs.DataColumns.Count(); c++ {
+		if !rs.DataColumns.Bit(c) || tce.pkIndexes[c] != -1 {
+			continue
+		}
+		if before != nil && rs.IdentifyColumns.Bit(c) && before[c].Type() == after[c].Type() && bytes.Equal(before[c].ToBytes(), after[c].ToBytes()) {
+			continue
+		}
+		names = append(names, tce.columnFields[c])
+		values = append(values, after[c])
+	}
+	return names, values
+}
@@ -23,6 +23,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/schema"
 
@@ -273,6 +274,178 @@ func TestStreamerParseRBREvents(t *testing.T) {
 	}
 }
 
+func TestStreamerParseRBRColumnValues(t *testing.T) {
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.ServerID = 62344
+
+	se := schema.NewEngineForTests()
+	se.SetTableForTests(&schema.Table{
+		Name: sqlparser.NewTableIdent("vt_a"),
+		Columns: []schema.TableColumn{
+			{
+				Name: sqlparser.NewColIdent("id"),
+				Type: querypb.Type_INT32,
+			},
+			{
+				Name: sqlparser.NewColIdent("message"),
+				Type: querypb.Type_VARCHAR,
+			},
+			{
+				Name: sqlparser.NewColIdent("count"),
+				Type: querypb.Type_INT32,
+			},
+		},
+		PKColumns: []int{0},
+	})
+
+	tableID := uint64(0x102030405060)
+	tm := &mysql.TableMap{
+		Flags:    0x8090,
+		Database: "vt_test_keyspace",
+		Name:     "vt_a",
+		Types: []byte{
+			mysql.TypeLong,
+			mysql.TypeVarchar,
+			mysql.TypeLong,
+		},
+		CanBeNull: mysql.NewServerBitmap(3),
+		Metadata: []uint16{
+			0,
+			384, // A VARCHAR(128) in utf8 would result in 384.
+			0,
+		},
+	}
+	tm.CanBeNull.Set(1, true)
+
+	insertRows := mysql.Rows{
+		Flags:       0x1234,
+		DataColumns: mysql.NewServerBitmap(3),
+		Rows: []mysql.Row{
+			{
+				NullColumns: mysql.NewServerBitmap(3),
+				Data: []byte{
+					0x01, 0x00, 0x00, 0x00, // 1
+					0x03, 0x00, // len('abc')
+					'a', 'b', 'c', // 'abc'
+					0x02, 0x00, 0x00, 0x00, // 2
+				},
+			},
+		},
+	}
+	insertRows.DataColumns.Set(0, true)
+	insertRows.DataColumns.Set(1, true)
+	insertRows.DataColumns.Set(2, true)
+
+	// Only message changes.
+	updateRows := mysql.Rows{
+		Flags:           0x1234,
+		IdentifyColumns: mysql.NewServerBitmap(3),
+		DataColumns:     mysql.NewServerBitmap(3),
+		Rows: []mysql.Row{
+			{
+				NullIdentifyColumns: mysql.NewServerBitmap(3),
+				NullColumns:         mysql.NewServerBitmap(3),
+				Identify: []byte{
+					0x01, 0x00, 0x00, 0x00, // 1
+					0x03, 0x00, // len('abc')
+					'a', 'b', 'c', // 'abc'
+					0x02, 0x00, 0x00, 0x00, // 2
+				},
+				Data: []byte{
+					0x01, 0x00, 0x00, 0x00, // 1
+					0x02, 0x00, 0x00, 0x00, // 2
+				},
+			},
+		},
+	}
+	updateRows.IdentifyColumns.Set(0, true)
+	updateRows.IdentifyColumns.Set(1, true)
+	updateRows.IdentifyColumns.Set(2, true)
+	updateRows.DataColumns.Set(0, true)
+	updateRows.DataColumns.Set(1, true)
+	updateRows.DataColumns.Set(2, true)
+	updateRows.Rows[0].NullColumns.Set(1, true)
+
+	deleteRows := mysql.Rows{
+		Flags:           0x1234,
+		IdentifyColumns: mysql.NewServerBitmap(3),
+		Rows: []mysql.Row{
+			{
+				NullIdentifyColumns: mysql.NewServerBitmap(3),
+				Identify: []byte{
+					0x01, 0x00, 0x00, 0x00, // 1
+					0x02, 0x00, 0x00, 0x00, // 2
+				},
+			},
+		},
+	}
+	deleteRows.IdentifyColumns.Set(0, true)
+	deleteRows.IdentifyColumns.Set(1, true)
+	deleteRows.IdentifyColumns.Set(2, true)
+	deleteRows.Rows[0].NullIdentifyColumns.Set(1, true)
+
+	input := []mysql.BinlogEvent{
+		mysql.NewRotateEvent(f, s, 0, ""),
+		mysql.NewFormatDescriptionEvent(f, s),
+		mysql.NewTableMapEvent(f, s, tableID, tm),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0xd}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "BEGIN"}),
+		mysql.NewWriteRowsEvent(f, s, tableID, insertRows),
+		mysql.NewUpdateRowsEvent(f, s, tableID, updateRows),
+		mysql.NewDeleteRowsEvent(f, s, tableID, deleteRows),
+		mysql.NewXIDEvent(f, s),
+	}
+
+	var got []FullBinlogStatement
+	sendTransaction := func(eventToken *querypb.EventToken, statements []FullBinlogStatement) error {
+		for _, statement := range statements {
+			if statement.Table != "" {
+				got = append(got, statement)
+			}
+		}
+		return nil
+	}
+	bls := NewStreamer(&mysql.ConnParams{DbName: "vt_test_keyspace"}, se, nil, mysql.Position{}, 0, sendTransaction)
+	bls.extractPK = true
+
+	events := make(chan mysql.BinlogEvent)
+	go sendTestEvents(events, input)
+	if _, err := bls.parseEvents(context.Background(), events); err != ErrServerEOF {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	message := &querypb.Field{Name: "message", Type: querypb.Type_VARCHAR}
+	count := &querypb.Field{Name: "count", Type: querypb.Type_INT32}
+	testcases := []struct {
+		names  []*querypb.Field
+		values []sqltypes.Value
+	}{{
+		// The insert writes every non-PK column.
+		names:  []*querypb.Field{message, count},
+		values: []sqltypes.Value{sqltypes.NewVarChar("abc"), sqltypes.NewInt32(2)},
+	}, {
+		// The update only changes message, to NULL.
+		names:  []*querypb.Field{message},
+		values: []sqltypes.Value{sqltypes.NULL},
+	}, {
+		// The delete has no new values.
+	}}
+	if len(got) != len(testcases) {
+		t.Fatalf("got %d statements, want %d: %v", len(got), len(testcases), got)
+	}
+	for i, tcase := range testcases {
+		if !reflect.DeepEqual(got[i].ColumnNames, tcase.names) || !reflect.DeepEqual(got[i].ColumnValues, tcase.values) {
+			t.Errorf("statement %d: %v, %v, want %v, %v", i, got[i].ColumnNames, got[i].ColumnValues, tcase.names, tcase.values)
+		}
+		if want := []sqltypes.Value{sqltypes.NewInt32(1)}; !reflect.DeepEqual(got[i].PKValues, want) {
+			t.Errorf("statement %d: PKValues %v, want %v", i, got[i].PKValues, want)
+		}
+	}
+}
+
 func TestStreamerParseRBRNameEscapes(t *testing.T) {
 	f := mysql.NewMySQL56BinlogFormat()
 	s := mysql.NewFakeBinlogStream()
@@ -17,7 +17,9 @@ limitations under the License.
 package binlog
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"strings"
 	"testing"
@@ -542,6 +544,189 @@ func TestStreamerParseEventsRollback(t *testing.T) {
 	}
 }
 
+func TestStreamerParseEventsSavepoint(t *testing.T) {
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.ServerID = 62344
+
+	input := []mysql.BinlogEvent{
+		mysql.NewRotateEvent(f, s, 0, ""),
+		mysql.NewFormatDescriptionEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0xd}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "BEGIN"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "insert into vt_a(eid, id) values (1, 1) /* _stream vt_a (eid id ) (1 1 ); */"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "SAVEPOINT `sp1`"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "ROLLBACK TO `sp1`"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "insert into vt_a(eid, id) values (1, 2) /* _stream vt_a (eid id ) (1 2 ); */"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "RELEASE SAVEPOINT `sp1`"}),
+		mysql.NewXIDEvent(f, s),
+	}
+
+	events := make(chan mysql.BinlogEvent)
+
+	want := []binlogdatapb.BinlogTransaction{
+		{
+			Statements: []*binlogdatapb.BinlogTransaction_Statement{
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_SET, Sql: []byte("SET TIMESTAMP=1407805592")},
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT, Sql: []byte("insert into vt_a(eid, id) values (1, 1) /* _stream vt_a (eid id ) (1 1 ); */")},
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_SET, Sql: []byte("SET TIMESTAMP=1407805592")},
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT, Sql: []byte("insert into vt_a(eid, id) values (1, 2) /* _stream vt_a (eid id ) (1 2 ); */")},
+			},
+			EventToken: &querypb.EventToken{
+				Timestamp: 1407805592,
+				Position: mysql.EncodePosition(mysql.Position{
+					GTIDSet: mysql.MariadbGTID{
+						Domain:   0,
+						Server:   62344,
+						Sequence: 0x0d,
+					},
+				}),
+			},
+		},
+	}
+	var got binlogStatements
+	bls := NewStreamer(&mysql.ConnParams{DbName: "vt_test_keyspace"}, nil, nil, mysql.Position{}, 0, (&got).sendTransaction)
+
+	go sendTestEvents(events, input)
+	if _, err := bls.parseEvents(context.Background(), events); err != ErrServerEOF {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !got.equal(want) {
+		t.Errorf("binlogConnStreamer.parseEvents(): got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestStreamerParseEventsXA(t *testing.T) {
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.ServerID = 62344
+
+	input := []mysql.BinlogEvent{
+		mysql.NewRotateEvent(f, s, 0, ""),
+		mysql.NewFormatDescriptionEvent(f, s),
+		// Prepared, then rolled back.
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0xd}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA START 'a'"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "insert into vt_a(eid, id) values (1, 1) /* _stream vt_a (eid id ) (1 1 ); */"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA END 'a'"}),
+		mysql.NewXAPrepareEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0xe}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA ROLLBACK 'a'"}),
+		// Prepared, then committed.
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0xf}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA START 'b'"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "insert into vt_a(eid, id) values (1, 2) /* _stream vt_a (eid id ) (1 2 ); */"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA END 'b'"}),
+		mysql.NewXAPrepareEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0x10}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA COMMIT 'b'"}),
+		// Committed in one phase.
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 0x11}, false /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA START 'c'"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "insert into vt_a(eid, id) values (1, 3) /* _stream vt_a (eid id ) (1 3 ); */"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA END 'c'"}),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Database: "vt_test_keyspace",
+			SQL:      "XA COMMIT 'c' ONE PHASE"}),
+	}
+
+	events := make(chan mysql.BinlogEvent)
+
+	want := []binlogdatapb.BinlogTransaction{
+		{
+			Statements: nil,
+			EventToken: &querypb.EventToken{
+				Timestamp: 1407805592,
+				Position: mysql.EncodePosition(mysql.Position{
+					GTIDSet: mysql.MariadbGTID{
+						Domain:   0,
+						Server:   62344,
+						Sequence: 0x0e,
+					},
+				}),
+			},
+		},
+		{
+			Statements: []*binlogdatapb.BinlogTransaction_Statement{
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_SET, Sql: []byte("SET TIMESTAMP=1407805592")},
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT, Sql: []byte("insert into vt_a(eid, id) values (1, 2) /* _stream vt_a (eid id ) (1 2 ); */")},
+			},
+			EventToken: &querypb.EventToken{
+				Timestamp: 1407805592,
+				Position: mysql.EncodePosition(mysql.Position{
+					GTIDSet: mysql.MariadbGTID{
+						Domain:   0,
+						Server:   62344,
+						Sequence: 0x10,
+					},
+				}),
+			},
+		},
+		{
+			Statements: []*binlogdatapb.BinlogTransaction_Statement{
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_SET, Sql: []byte("SET TIMESTAMP=1407805592")},
+				{Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT, Sql: []byte("insert into vt_a(eid, id) values (1, 3) /* _stream vt_a (eid id ) (1 3 ); */")},
+			},
+			EventToken: &querypb.EventToken{
+				Timestamp: 1407805592,
+				Position: mysql.EncodePosition(mysql.Position{
+					GTIDSet: mysql.MariadbGTID{
+						Domain:   0,
+						Server:   62344,
+						Sequence: 0x11,
+					},
+				}),
+			},
+		},
+	}
+	var got binlogStatements
+	bls := NewStreamer(&mysql.ConnParams{DbName: "vt_test_keyspace"}, nil, nil, mysql.Position{}, 0, (&got).sendTransaction)
+
+	go sendTestEvents(events, input)
+	if _, err := bls.parseEvents(context.Background(), events); err != ErrServerEOF {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !got.equal(want) {
+		t.Errorf("binlogConnStreamer.parseEvents(): got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
 func TestStreamerParseEventsDMLWithoutBegin(t *testing.T) {
 	f := mysql.NewMySQL56BinlogFormat()
 	s := mysql.NewFakeBinlogStream()
@@ -1030,6 +1215,10 @@ func TestGetStatementCategory(t *testing.T) {
 		"TRUNCATE something":                      binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 		"RENAME something":                        binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 		"SET something=nothing":                   binlogdatapb.BinlogTransaction_Statement_BL_SET,
+		"SET ROLE r1":                             binlogdatapb.BinlogTransaction_Statement_BL_SET,
+		"SET DEFAULT ROLE r1 TO u1":               binlogdatapb.BinlogTransaction_Statement_BL_SET,
+		"GRANT PROXY ON u1 TO u2":                 binlogdatapb.BinlogTransaction_Statement_BL_DDL,
+		"REVOKE PROXY ON u1 FROM u2":              binlogdatapb.BinlogTransaction_Statement_BL_DDL,
 	}
 
 	for input, want := range table {
@@ -1038,3 +1227,185 @@ func TestGetStatementCategory(t *testing.T) {
 		}
 	}
 }
+
+func TestIsSavepointStatement(t *testing.T) {
+	table := map[string]bool{
+		"":                              false,
+		"ROLLBACK":                      false,
+		"ROLLBACK WORK":                 false,
+		"COMMIT":                        false,
+		"SAVEPOINT `sp1`":               true,
+		"savepoint sp1":                 true,
+		"RELEASE SAVEPOINT `sp1`":       true,
+		"ROLLBACK TO `sp1`":             true,
+		"ROLLBACK TO SAVEPOINT `sp1`":   true,
+		"rollback work to savepoint sp": true,
+	}
+
+	for input, want := range table {
+		if got := isSavepointStatement(input); got != want {
+			t.Errorf("isSavepointStatement(%v) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestParseXAStatement(t *testing.T) {
+	testcases := []struct {
+		sql      string
+		verb     string
+		xid      string
+		onePhase bool
+		ok       bool
+	}{
+		{sql: "", ok: false},
+		{sql: "XA", ok: false},
+		{sql: "BEGIN", ok: false},
+		{sql: "xa unknown 'a'", ok: false},
+		{sql: "XA START 'a'", verb: "start", xid: "'a'", ok: true},
+		{sql: "xa begin 'a','b'", verb: "begin", xid: "'a','b'", ok: true},
+		{sql: "XA START 'a' JOIN", verb: "start", xid: "'a'", ok: true},
+		{sql: "XA END 'a'", verb: "end", xid: "'a'", ok: true},
+		{sql: "XA END 'a' SUSPEND FOR MIGRATE", verb: "end", xid: "'a'", ok: true},
+		{sql: "XA PREPARE 'a'", verb: "prepare", xid: "'a'", ok: true},
+		{sql: "XA COMMIT 'a'", verb: "commit", xid: "'a'", ok: true},
+		{sql: "XA COMMIT X'61',X'',1 ONE PHASE", verb: "commit", xid: "X'61',X'',1", onePhase: true, ok: true},
+		{sql: "XA ROLLBACK  'a'", verb: "rollback", xid: "'a'", ok: true},
+		{sql: "XA RECOVER", verb: "recover", ok: true},
+	}
+
+	for _, tc := range testcases {
+		verb, xid, onePhase, ok := parseXAStatement(tc.sql)
+		if verb != tc.verb || xid != tc.xid || onePhase != tc.onePhase || ok != tc.ok {
+			t.Errorf("parseXAStatement(%q) = (%q, %q, %v, %v), want (%q, %q, %v, %v)", tc.sql, verb, xid, onePhase, ok, tc.verb, tc.xid, tc.onePhase, tc.ok)
+		}
+	}
+}
+
+func TestGTIDGap(t *testing.T) {
+	sid := mysql.SID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	otherSID := mysql.SID{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	set56 := mysql.Mysql56GTIDSet{}.AddGTID(mysql.Mysql56GTID{Server: sid, Sequence: 5})
+	testcases := []struct {
+		set  mysql.GTIDSet
+		gtid mysql.GTID
+		want bool
+	}{{
+		set:  set56,
+		gtid: mysql.Mysql56GTID{Server: sid, Sequence: 6},
+		want: false,
+	}, {
+		set:  set56,
+		gtid: mysql.Mysql56GTID{Server: sid, Sequence: 8},
+		want: true,
+	}, {
+		set:  set56,
+		gtid: mysql.Mysql56GTID{Server: otherSID, Sequence: 8},
+		want: false,
+	}, {
+		set:  mysql.MariadbGTID{Domain: 1, Server: 2, Sequence: 5},
+		gtid: mysql.MariadbGTID{Domain: 1, Server: 3, Sequence: 6},
+		want: false,
+	}, {
+		set:  mysql.MariadbGTID{Domain: 1, Server: 2, Sequence: 5},
+		gtid: mysql.MariadbGTID{Domain: 1, Server: 2, Sequence: 8},
+		want: true,
+	}, {
+		set:  mysql.MariadbGTID{Domain: 1, Server: 2, Sequence: 5},
+		gtid: mysql.MariadbGTID{Domain: 2, Server: 2, Sequence: 8},
+		want: false,
+	}}
+	for _, tcase := range testcases {
+		if got := gtidGap(tcase.set, tcase.gtid); got != tcase.want {
+			t.Errorf("gtidGap(%v, %v): %v, want %v", tcase.set, tcase.gtid, got, tcase.want)
+		}
+	}
+}
+
+func TestCheckGTID(t *testing.T) {
+	pos := mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Sequence: 10}}
+
+	before := binlogStreamerGTIDs.Counts()
+	if err := checkGTID(pos, mysql.MariadbGTID{Domain: 0, Sequence: 11}); err != nil {
+		t.Errorf("checkGTID(next): %v", err)
+	}
+	if err := checkGTID(pos, mysql.MariadbGTID{Domain: 0, Sequence: 10}); err != nil {
+		t.Errorf("checkGTID(duplicate): %v", err)
+	}
+	if err := checkGTID(pos, mysql.MariadbGTID{Domain: 0, Sequence: 12}); err != nil {
+		t.Errorf("checkGTID(gap): %v", err)
+	}
+	after := binlogStreamerGTIDs.Counts()
+	if got := after["Duplicate"] - before["Duplicate"]; got != 1 {
+		t.Errorf("Duplicate count change = %v, want 1", got)
+	}
+	if got := after["Gap"] - before["Gap"]; got != 1 {
+		t.Errorf("Gap count change = %v, want 1", got)
+	}
+
+	*strictGTIDOrder = true
+	defer func() { *strictGTIDOrder = false }()
+	want := "GTID 0-0-12 is not contiguous with the current position 0-0-10"
+	if err := checkGTID(pos, mysql.MariadbGTID{Domain: 0, Sequence: 12}); err == nil || err.Error() != want {
+		t.Errorf("checkGTID(gap) in strict mode: %v, want %s", err, want)
+	}
+}
+
+// TestStreamerParseEventsFlavorChange tests a stream that starts from a
+// MySQL 5.6 position, and then gets a MariaDB GTID.
+func TestStreamerParseEventsFlavorChange(t *testing.T) {
+	f := mysql.NewMariaDBBinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+
+	input := []mysql.BinlogEvent{
+		mysql.NewFormatDescriptionEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 10}, true /* hasBegin */),
+	}
+
+	events := make(chan mysql.BinlogEvent)
+
+	sendTransaction := func(eventToken *querypb.EventToken, statements []FullBinlogStatement) error {
+		return nil
+	}
+	startPos := mysql.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	bls := NewStreamer(&mysql.ConnParams{DbName: "vt_test_keyspace"}, nil, nil, startPos, 0, sendTransaction)
+	before := binlogStreamerGTIDs.Counts()["FlavorMismatch"]
+
+	go sendTestEvents(events, input)
+	_, err := bls.parseEvents(context.Background(), events)
+	want := "GTID 0-1-10 has flavor MariaDB, but the current position 00010203-0405-0607-0809-0a0b0c0d0e0f:1-5 has flavor MySQL56"
+	if err == nil || err.Error() != want {
+		t.Errorf("wrong error, got %v, want %v", err, want)
+	}
+	if got := binlogStreamerGTIDs.Counts()["FlavorMismatch"] - before; got != 1 {
+		t.Errorf("FlavorMismatch count change = %v, want 1", got)
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	ev := mysql.NewQueryEvent(f, s, mysql.Query{
+		Database: "vt_test_keyspace",
+		SQL:      "insert into vt_a(eid, id) values (1, 1)",
+	})
+
+	// The fake events have a zero checksum.
+	stripped, checksum, err := ev.StripChecksum(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantErr := "binlog event checksum mismatch"
+	if err := verifyChecksum(stripped, checksum); err == nil || !strings.HasPrefix(err.Error(), wantErr) {
+		t.Errorf("verifyChecksum: %v, want %s", err, wantErr)
+	}
+
+	buf := ev.Bytes()
+	binary.LittleEndian.PutUint32(buf[len(buf)-4:], crc32.ChecksumIEEE(buf[:len(buf)-4]))
+	stripped, checksum, err = mysql.NewMysql56BinlogEvent(buf).StripChecksum(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyChecksum(stripped, checksum); err != nil {
+		t.Errorf("verifyChecksum: %v", err)
+	}
+}
@@ -116,7 +116,8 @@ func (evs *EventStreamer) transactionToEvent(eventToken *querypb.EventToken, sta
 
 /*
 buildDMLStatement recovers the PK from a FullBinlogStatement.
-For RBR, the values are already in there, just need to be translated.
+For RBR, the values are already in there, just need to be translated,
+along with the values of the changed columns.
 For SBR, parses the tuples of the full stream comment.
 The _stream comment is extracted into a StreamEvent.Statement.
 */
@@ -131,6 +132,10 @@ func (evs *EventStreamer) buildDMLStatement(stmt FullBinlogStatement, insertid i
 			TableName:        stmt.Table,
 			PrimaryKeyFields: stmt.PKNames,
 			PrimaryKeyValues: []*querypb.Row{sqltypes.RowToProto3(stmt.PKValues)},
+			ColumnNames:      stmt.ColumnNames,
+		}
+		if stmt.ColumnValues != nil {
+			dmlStatement.ColumnValues = []*querypb.Row{sqltypes.RowToProto3(stmt.ColumnValues)}
 		}
 		// InsertID is only needed to fill in the ID on next queries,
 		// but if we use RBR, it's already in the values, so just return 0.
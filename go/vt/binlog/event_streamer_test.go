@@ -18,10 +18,14 @@ package binlog
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 
+	"github.com/youtube/vitess/go/sqltypes"
+
 	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
@@ -165,6 +169,50 @@ func TestDMLEvent(t *testing.T) {
 	}
 }
 
+func TestRBRDMLEvent(t *testing.T) {
+	statements := []FullBinlogStatement{
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("UPDATE vt_a SET id=1, message='abcd' WHERE id=1 AND message='abc'"),
+			},
+			Table:        "vt_a",
+			PKNames:      []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+			PKValues:     []sqltypes.Value{sqltypes.NewInt64(1)},
+			ColumnNames:  []*querypb.Field{{Name: "message", Type: sqltypes.VarChar}},
+			ColumnValues: []sqltypes.Value{sqltypes.NewVarChar("abcd")},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_DELETE,
+				Sql:      []byte("DELETE FROM vt_a WHERE id=1 AND message='abcd'"),
+			},
+			Table:    "vt_a",
+			PKNames:  []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+			PKValues: []sqltypes.Value{sqltypes.NewInt64(1)},
+		},
+	}
+	var got []string
+	evs := &EventStreamer{
+		sendEvent: func(event *querypb.StreamEvent) error {
+			for _, statement := range event.Statements {
+				got = append(got, fmt.Sprintf("%v", statement))
+			}
+			return nil
+		},
+	}
+	if err := evs.transactionToEvent(&querypb.EventToken{}, statements); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`category:DML table_name:"vt_a" primary_key_fields:<name:"id" type:INT64 > primary_key_values:<lengths:1 values:"1" > column_names:<name:"message" type:VARCHAR > column_values:<lengths:4 values:"abcd" > `,
+		`category:DML table_name:"vt_a" primary_key_fields:<name:"id" type:INT64 > primary_key_values:<lengths:1 values:"1" > `,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
 func TestDDLEvent(t *testing.T) {
 	statements := []FullBinlogStatement{
 		{
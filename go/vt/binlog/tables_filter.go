@@ -17,10 +17,15 @@ limitations under the License.
 package binlog
 
 import (
+	"flag"
+	"fmt"
+	"regexp"
 	"strings"
 
 	log "github.com/golang/glog"
 
+	"github.com/youtube/vitess/go/vt/sqlparser"
+
 	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
@@ -30,11 +35,128 @@ const (
 	space         = " "
 )
 
+var fallbackMaxTables = flag.Int("binlog_fallback_max_tables", 1, "Maximum number of tables a statement without stream comment may reference for the tables filter to forward it. Statements which reference more tables are ambiguous: they are logged and counted in UpdateStreamTablesAmbiguous instead. A value above 1 forwards multi-table statements which can reference tables that don't exist on the destination.")
+
+// tableIdentPattern matches a table name with an optional database
+// qualifier. Either part may be quoted with backticks.
+const tableIdentPattern = "(?:`[^`]+`|\\w+)(?:\\.(?:`[^`]+`|\\w+))?"
+
+// dmlTableRegexp matches the table name of single-table INSERT, REPLACE,
+// UPDATE and DELETE statements. Statements which reference more than one
+// table (e.g. joins or aliases) don't match.
+var dmlTableRegexp = regexp.MustCompile("(?is)^\\s*(?:" +
+	`(?:insert|replace)(?:\s+(?:low_priority|delayed|high_priority|ignore))*(?:\s+into)?\s+(` + tableIdentPattern + `)(?:\s*\(|\s+(?:values?|select|set)\b)` +
+	`|update(?:\s+(?:low_priority|ignore))*\s+(` + tableIdentPattern + `)\s+set\b` +
+	`|delete(?:\s+(?:low_priority|quick|ignore))*\s+from\s+(` + tableIdentPattern + `)(?:\s+(?:where|order|limit)\b|\s*$)` +
+	")")
+
+// multiTableRegexp matches the table references of multi-table UPDATE
+// and DELETE statements, and of single-table ones with an alias.
+var multiTableRegexp = regexp.MustCompile("(?is)^\\s*(?:" +
+	`update(?:\s+(?:low_priority|ignore))*\s+(.+?)\s+set\b` +
+	`|delete(?:\s+(?:low_priority|quick|ignore))*\s+from\s+.+?\s+using\s+(.+?)(?:\s+where\b|\s*$)` +
+	`|delete(?:\s+(?:low_priority|quick|ignore))*\s+.+?\s+from\s+(.+?)(?:\s+where\b|\s*$)` +
+	")")
+
+// tableRefSeparatorRegexp splits table references at commas and joins.
+var tableRefSeparatorRegexp = regexp.MustCompile("(?is)\\s*,\\s*|\\s+(?:(?:inner|cross|(?:natural\\s+)?(?:left|right)(?:\\s+outer)?|natural)\\s+)?join\\s+|\\s+straight_join\\s+")
+
+// tableRefRegexp matches the table name at the start of a table reference.
+var tableRefRegexp = regexp.MustCompile("^(" + tableIdentPattern + ")(?:\\s|$)")
+
+// extractTableName returns the database qualifier, if any, and the name
+// of the table modified by sql. It's used for statements which have no
+// stream comment because they were not executed through vttablet.
+func extractTableName(sql string) (qualifier, name string, ok bool) {
+	match := dmlTableRegexp.FindStringSubmatch(sqlparser.StripLeadingComments(sql))
+	if match == nil {
+		return "", "", false
+	}
+	qualifier, name = splitTableIdent(match[1] + match[2] + match[3])
+	return qualifier, name, true
+}
+
+// extractTableNames returns the qualified names of all tables referenced
+// by a multi-table UPDATE or DELETE. The modified tables are among them,
+// but they can't be told apart from the other ones without a full parse.
+// Table references which don't start with a table name, e.g. derived
+// tables, make the statement unparseable.
+func extractTableNames(sql string) (qualifiers, names []string, ok bool) {
+	match := multiTableRegexp.FindStringSubmatch(sqlparser.StripLeadingComments(sql))
+	if match == nil {
+		return nil, nil, false
+	}
+	for _, ref := range tableRefSeparatorRegexp.Split(match[1]+match[2]+match[3], -1) {
+		table := tableRefRegexp.FindStringSubmatch(ref)
+		if table == nil {
+			return nil, nil, false
+		}
+		qualifier, name := splitTableIdent(table[1])
+		qualifiers = append(qualifiers, qualifier)
+		names = append(names, name)
+	}
+	return qualifiers, names, true
+}
+
+// splitTableIdent splits a table identifier matched by tableIdentPattern
+// into its qualifier and name, without backticks.
+func splitTableIdent(ident string) (qualifier, name string) {
+	name = ident
+	if strings.HasPrefix(name, "`") {
+		end := strings.Index(name[1:], "`") + 1
+		if end == len(name)-1 {
+			return "", name[1:end]
+		}
+		// Split at the dot after the quoted qualifier.
+		qualifier, name = name[1:end], name[end+2:]
+	} else if i := strings.Index(name, "."); i != -1 {
+		qualifier, name = name[:i], name[i+1:]
+	}
+	return qualifier, strings.Trim(name, "`")
+}
+
+// tableNamesFromSQL returns the table name from the stream comment which
+// vttablet adds to DMLs. For other statements, it falls back to
+// extractTableName(), and then to extractTableNames() for statements
+// which reference at most -binlog_fallback_max_tables tables. A table
+// qualified with a database other than dbname is an error: it could have
+// the same name as one of our tables.
+func tableNamesFromSQL(dbname, sql string) ([]string, error) {
+	tableIndex := strings.LastIndex(sql, streamComment)
+	if tableIndex == -1 {
+		qualifiers, names, ok := extractTableNames(sql)
+		if qualifier, name, single := extractTableName(sql); single {
+			qualifiers, names, ok = []string{qualifier}, []string{name}, true
+		}
+		if !ok {
+			return nil, fmt.Errorf("can't parse table name")
+		}
+		if len(names) > *fallbackMaxTables {
+			tablesAmbiguous.Add(1)
+			return nil, fmt.Errorf("statement references %d tables, more than -binlog_fallback_max_tables=%d", len(names), *fallbackMaxTables)
+		}
+		for i, qualifier := range qualifiers {
+			if qualifier != "" && qualifier != dbname {
+				return nil, fmt.Errorf("table %v.%v is not in database %v", qualifier, names[i], dbname)
+			}
+		}
+		tablesFallbacks.Add(1)
+		return names, nil
+	}
+	tableStart := tableIndex + len(streamComment)
+	tableEnd := strings.Index(sql[tableStart:], space)
+	if tableEnd == -1 {
+		return nil, fmt.Errorf("can't parse table name from stream comment")
+	}
+	return []string{sql[tableStart : tableStart+tableEnd]}, nil
+}
+
 // TablesFilterFunc returns a function that calls callback only if statements
-// in the transaction match the specified tables. The resulting function can be
-// passed into the Streamer: bls.Stream(file, pos, sendTransaction) ->
-// bls.Stream(file, pos, TablesFilterFunc(sendTransaction))
-func TablesFilterFunc(tables []string, callback func(*binlogdatapb.BinlogTransaction) error) sendTransactionFunc {
+// in the transaction match the specified tables of database dbname. The
+// resulting function can be passed into the Streamer:
+// bls.Stream(file, pos, sendTransaction) ->
+// bls.Stream(file, pos, TablesFilterFunc(dbname, tables, sendTransaction))
+func TablesFilterFunc(dbname string, tables []string, callback func(*binlogdatapb.BinlogTransaction) error) sendTransactionFunc {
 	return func(eventToken *querypb.EventToken, statements []FullBinlogStatement) error {
 		matched := false
 		filtered := make([]*binlogdatapb.BinlogTransaction_Statement, 0, len(statements))
@@ -48,33 +170,22 @@ func TablesFilterFunc(tables []string, callback func(*binlogdatapb.BinlogTransac
 			case binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
 				binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
 				binlogdatapb.BinlogTransaction_Statement_BL_DELETE:
-				tableName := statement.Table
-				if tableName == "" {
+				tableNames := []string{statement.Table}
+				if statement.Table == "" {
 					// The statement doesn't
 					// contain the table name (SBR
 					// event), figure it out.
 					sql := string(statement.Statement.Sql)
-					tableIndex := strings.LastIndex(sql, streamComment)
-					if tableIndex == -1 {
+					var err error
+					if tableNames, err = tableNamesFromSQL(dbname, sql); err != nil {
 						updateStreamErrors.Add("TablesStream", 1)
-						log.Errorf("Error parsing table name: %s", sql)
+						log.Errorf("Not forwarding statement: %v: %s", err, sql)
 						continue
 					}
-					tableStart := tableIndex + len(streamComment)
-					tableEnd := strings.Index(sql[tableStart:], space)
-					if tableEnd == -1 {
-						updateStreamErrors.Add("TablesStream", 1)
-						log.Errorf("Error parsing table name: %s", sql)
-						continue
-					}
-					tableName = sql[tableStart : tableStart+tableEnd]
 				}
-				for _, t := range tables {
-					if t == tableName {
-						filtered = append(filtered, statement.Statement)
-						matched = true
-						break
-					}
+				if anyTableMatches(tables, tableNames) {
+					filtered = append(filtered, statement.Statement)
+					matched = true
 				}
 			case binlogdatapb.BinlogTransaction_Statement_BL_UNRECOGNIZED:
 				updateStreamErrors.Add("TablesStream", 1)
@@ -92,3 +203,15 @@ func TablesFilterFunc(tables []string, callback func(*binlogdatapb.BinlogTransac
 		return callback(trans)
 	}
 }
+
+// anyTableMatches returns true if one of names is in tables.
+func anyTableMatches(tables, names []string) bool {
+	for _, t := range tables {
+		for _, name := range names {
+			if t == name {
+				return true
+			}
+		}
+	}
+	return false
+}
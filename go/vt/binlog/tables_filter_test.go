@@ -17,12 +17,15 @@ limitations under the License.
 package binlog
 
 import (
+	"reflect"
 	"testing"
 
 	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
 
+const testDBName = "vt_test_keyspace"
+
 var testTables = []string{
 	"included1",
 	"included2",
@@ -53,7 +56,7 @@ func TestTablesFilterPass(t *testing.T) {
 		Position: "MariaDB/0-41983-1",
 	}
 	var got string
-	f := TablesFilterFunc(testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
 		got = bltToString(reply)
 		return nil
 	})
@@ -83,7 +86,7 @@ func TestTablesFilterSkip(t *testing.T) {
 		Position: "MariaDB/0-41983-1",
 	}
 	var got string
-	f := TablesFilterFunc(testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
 		got = bltToString(reply)
 		return nil
 	})
@@ -113,7 +116,7 @@ func TestTablesFilterDDL(t *testing.T) {
 		Position: "MariaDB/0-41983-1",
 	}
 	var got string
-	f := TablesFilterFunc(testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
 		got = bltToString(reply)
 		return nil
 	})
@@ -149,7 +152,7 @@ func TestTablesFilterMalformed(t *testing.T) {
 		Position: "MariaDB/0-41983-1",
 	}
 	var got string
-	f := TablesFilterFunc(testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
 		got = bltToString(reply)
 		return nil
 	})
@@ -159,3 +162,232 @@ func TestTablesFilterMalformed(t *testing.T) {
 		t.Errorf("want %s, got %s", want, got)
 	}
 }
+
+func TestTablesFilterNoStreamComment(t *testing.T) {
+	statements := []FullBinlogStatement{
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update included1 set a = 1 where id = 2"),
+			},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_DELETE,
+				Sql:      []byte("delete from excluded1 where id = 2"),
+			},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update included1 join included2 set a = 1"),
+			},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update vt_test_keyspace.included2 set a = 1"),
+			},
+		},
+		{
+			// Same table name, but in another database.
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update otherdb.included1 set a = 1"),
+			},
+		},
+	}
+	eventToken := &querypb.EventToken{
+		Position: "MariaDB/0-41983-1",
+	}
+	var got string
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+		got = bltToString(reply)
+		return nil
+	})
+	errors := updateStreamErrors.Counts()["TablesStream"]
+	f(eventToken, statements)
+	want := `statement: <8, "update included1 set a = 1 where id = 2"> statement: <8, "update vt_test_keyspace.included2 set a = 1"> position: "MariaDB/0-41983-1" `
+	if want != got {
+		t.Errorf("want\n%s, got\n%s", want, got)
+	}
+	// The join and the other database's update are counted as errors.
+	if got, want := updateStreamErrors.Counts()["TablesStream"]-errors, int64(2); got != want {
+		t.Errorf("TablesStream errors: %d, want %d", got, want)
+	}
+}
+
+func TestTablesFilterFallbackMaxTables(t *testing.T) {
+	defer func(max int) { *fallbackMaxTables = max }(*fallbackMaxTables)
+	*fallbackMaxTables = 2
+
+	statements := []FullBinlogStatement{
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update excluded1 join included2 on excluded1.id = included2.id set included2.a = 1"),
+			},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_DELETE,
+				Sql:      []byte("delete e1 from excluded1 e1 join excluded2 e2 on e1.id = e2.id"),
+			},
+		},
+		{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update included1, included2, excluded1 set included1.a = 1"),
+			},
+		},
+	}
+	eventToken := &querypb.EventToken{
+		Position: "MariaDB/0-41983-1",
+	}
+	var got string
+	f := TablesFilterFunc(testDBName, testTables, func(reply *binlogdatapb.BinlogTransaction) error {
+		got = bltToString(reply)
+		return nil
+	})
+	ambiguous := tablesAmbiguous.Get()
+	f(eventToken, statements)
+	want := `statement: <8, "update excluded1 join included2 on excluded1.id = included2.id set included2.a = 1"> position: "MariaDB/0-41983-1" `
+	if want != got {
+		t.Errorf("want\n%s, got\n%s", want, got)
+	}
+	// The three-table update is more than the limit.
+	if got, want := tablesAmbiguous.Get()-ambiguous, int64(1); got != want {
+		t.Errorf("UpdateStreamTablesAmbiguous: %d, want %d", got, want)
+	}
+}
+
+func TestExtractTableName(t *testing.T) {
+	testcases := []struct {
+		sql       string
+		qualifier string
+		want      string
+	}{{
+		sql:  "insert into a(id) values (1)",
+		want: "a",
+	}, {
+		sql:       "INSERT IGNORE INTO `db`.`a` VALUES (1)",
+		qualifier: "db",
+		want:      "a",
+	}, {
+		sql:  "replace a set id = 1",
+		want: "a",
+	}, {
+		sql:  "insert into a select * from b",
+		want: "a",
+	}, {
+		sql:       "/* comment */ update low_priority db.a set id = 1 where id = 2",
+		qualifier: "db",
+		want:      "a",
+	}, {
+		sql:  "update `a.b` set id = 1",
+		want: "a.b",
+	}, {
+		sql:  "delete quick from a where id = 1",
+		want: "a",
+	}, {
+		sql:  "delete from a",
+		want: "a",
+	}, {
+		// Multi-table statements are ambiguous.
+		sql: "update a, b set a.id = b.id",
+	}, {
+		sql: "update a join b on a.id = b.id set a.id = 1",
+	}, {
+		sql: "delete a, b from a join b on a.id = b.id",
+	}, {
+		sql: "delete from a using a join b",
+	}, {
+		sql: "select * from a",
+	}}
+	for _, tc := range testcases {
+		qualifier, got, ok := extractTableName(tc.sql)
+		if ok != (tc.want != "") || qualifier != tc.qualifier || got != tc.want {
+			t.Errorf("extractTableName(%s): %s, %s, %v, want %s, %s", tc.sql, qualifier, got, ok, tc.qualifier, tc.want)
+		}
+	}
+}
+
+func TestExtractTableNames(t *testing.T) {
+	testcases := []struct {
+		sql        string
+		qualifiers []string
+		want       []string
+	}{{
+		sql:        "update a, b set a.id = b.id",
+		qualifiers: []string{"", ""},
+		want:       []string{"a", "b"},
+	}, {
+		sql:        "update a as t set t.id = 1",
+		qualifiers: []string{""},
+		want:       []string{"a"},
+	}, {
+		sql:        "UPDATE `db`.a INNER JOIN b USING (id) LEFT OUTER JOIN c ON b.id = c.id SET a.id = 1",
+		qualifiers: []string{"db", "", ""},
+		want:       []string{"a", "b", "c"},
+	}, {
+		sql:        "delete a, b from a join b on a.id = b.id where a.id = 1",
+		qualifiers: []string{"", ""},
+		want:       []string{"a", "b"},
+	}, {
+		sql:        "delete from a using a straight_join db.b",
+		qualifiers: []string{"", "db"},
+		want:       []string{"a", "b"},
+	}, {
+		// Derived tables can't be parsed.
+		sql: "update a join (select id from b) t on a.id = t.id set a.id = 1",
+	}, {
+		sql: "insert into a select * from b, c",
+	}}
+	for _, tc := range testcases {
+		qualifiers, got, ok := extractTableNames(tc.sql)
+		if ok != (tc.want != nil) || !reflect.DeepEqual(qualifiers, tc.qualifiers) || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("extractTableNames(%s): %v, %v, %v, want %v, %v", tc.sql, qualifiers, got, ok, tc.qualifiers, tc.want)
+		}
+	}
+}
+
+func TestTableNamesFromSQL(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want string
+		err  string
+	}{{
+		sql:  "update a set id = 1 /* _stream a (id ) (1 ); */",
+		want: "a",
+	}, {
+		sql:  "update a set id = 1",
+		want: "a",
+	}, {
+		sql:  "update `vt_test_keyspace`.a set id = 1",
+		want: "a",
+	}, {
+		sql: "update otherdb.a set id = 1",
+		err: "table otherdb.a is not in database vt_test_keyspace",
+	}, {
+		sql:  "update a t set t.id = 1",
+		want: "a",
+	}, {
+		sql: "update a, b set a.id = b.id",
+		err: "statement references 2 tables, more than -binlog_fallback_max_tables=1",
+	}, {
+		sql: "update a join (select id from b) t on a.id = t.id set a.id = 1",
+		err: "can't parse table name",
+	}}
+	for _, tc := range testcases {
+		got, err := tableNamesFromSQL(testDBName, tc.sql)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("tableNamesFromSQL(%s): %v, want %s", tc.sql, err, tc.err)
+			}
+			continue
+		}
+		if err != nil || len(got) != 1 || got[0] != tc.want {
+			t.Errorf("tableNamesFromSQL(%s): %v, %v, want %s", tc.sql, got, err, tc.want)
+		}
+	}
+}
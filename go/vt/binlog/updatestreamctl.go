@@ -53,6 +53,8 @@ var (
 	keyrangeTransactions = stats.NewInt("UpdateStreamKeyRangeTransactions")
 	tablesStatements     = stats.NewInt("UpdateStreamTablesStatements")
 	tablesTransactions   = stats.NewInt("UpdateStreamTablesTransactions")
+	tablesFallbacks      = stats.NewInt("UpdateStreamTablesFallbacks")
+	tablesAmbiguous      = stats.NewInt("UpdateStreamTablesAmbiguous")
 )
 
 // UpdateStreamControl is the interface an UpdateStream service implements
@@ -295,7 +297,7 @@ func (updateStream *UpdateStreamImpl) StreamTables(ctx context.Context, position
 	log.Infof("ServeUpdateStream starting @ %#v", pos)
 
 	// Calls cascade like this: binlog.Streamer->TablesFilterFunc->func(*binlogdatapb.BinlogTransaction)->callback
-	f := TablesFilterFunc(tables, func(trans *binlogdatapb.BinlogTransaction) error {
+	f := TablesFilterFunc(updateStream.cp.DbName, tables, func(trans *binlogdatapb.BinlogTransaction) error {
 		tablesStatements.Add(int64(len(trans.Statements)))
 		tablesTransactions.Add(1)
 		return callback(trans)
@@ -54,7 +54,10 @@ func (dbc *DBConnection) ExecuteFetch(query string, maxrows int, wantfields bool
 }
 
 // ExecuteStreamFetch overwrites mysql.Conn.ExecuteStreamFetch.
-func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltypes.Result) error, streamBufferSize int) error {
+// Rows are sent in batches of up to streamBufferSize bytes, or
+// streamBufferRows rows if it's not 0. If the callback fails, the
+// connection is closed instead of reading the rest of the result.
+func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltypes.Result) error, streamBufferSize, streamBufferRows int) error {
 	defer dbc.mysqlStats.Record("ExecStream", time.Now())
 
 	err := dbc.Conn.ExecuteStreamFetch(query)
@@ -71,6 +74,7 @@ func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltype
 	}
 	err = callback(&sqltypes.Result{Fields: flds})
 	if err != nil {
+		dbc.Close()
 		return fmt.Errorf("stream send error: %v", err)
 	}
 
@@ -92,9 +96,10 @@ func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltype
 			byteCount += s.Len()
 		}
 
-		if byteCount >= streamBufferSize {
+		if byteCount >= streamBufferSize || (streamBufferRows > 0 && len(qr.Rows) >= streamBufferRows) {
 			err = callback(qr)
 			if err != nil {
+				dbc.Close()
 				return err
 			}
 			// empty the rows so we start over, but we keep the
@@ -107,6 +112,7 @@ func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltype
 	if len(qr.Rows) > 0 {
 		err = callback(qr)
 		if err != nil {
+			dbc.Close()
 			return err
 		}
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/pools"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/sync2"
 )
 
 var (
@@ -52,6 +53,10 @@ type ConnectionPool struct {
 	capacity    int
 	idleTimeout time.Duration
 
+	// maxLifetime, if non-zero, is the age after which a connection
+	// is closed instead of being returned to the pool.
+	maxLifetime sync2.AtomicDuration
+
 	// info and mysqlStats are set at Open() time
 	info       *mysql.ConnParams
 	mysqlStats *stats.Timings
@@ -110,6 +115,7 @@ func (cp *ConnectionPool) connect() (pools.Resource, error) {
 	return &PooledDBConnection{
 		DBConnection: c,
 		pool:         cp,
+		created:      time.Now(),
 	}, nil
 }
 
@@ -182,6 +188,18 @@ func (cp *ConnectionPool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.idleTimeout = idleTimeout
 }
 
+// SetMaxLifetime sets the maximum age of connections in the pool.
+// Older connections are closed when they're recycled, and replaced
+// on demand. A value of 0 means connections are kept forever.
+func (cp *ConnectionPool) SetMaxLifetime(maxLifetime time.Duration) {
+	cp.maxLifetime.Set(maxLifetime)
+}
+
+// MaxLifetime returns the maximum age of connections in the pool.
+func (cp *ConnectionPool) MaxLifetime() time.Duration {
+	return cp.maxLifetime.Get()
+}
+
 // StatsJSON returns the pool stats as a JSOn object.
 func (cp *ConnectionPool) StatsJSON() string {
 	p := cp.pool()
@@ -16,17 +16,24 @@ limitations under the License.
 
 package dbconnpool
 
+import "time"
+
 // PooledDBConnection re-exposes DBConnection to be used by ConnectionPool.
 type PooledDBConnection struct {
 	*DBConnection
-	pool *ConnectionPool
+	pool    *ConnectionPool
+	created time.Time
 }
 
 // Recycle should be called to return the PooledDBConnection to the pool.
 func (pc *PooledDBConnection) Recycle() {
-	if pc.IsClosed() {
+	switch {
+	case pc.IsClosed():
+		pc.pool.Put(nil)
+	case pc.expired():
+		pc.Close()
 		pc.pool.Put(nil)
-	} else {
+	default:
 		pc.pool.Put(pc)
 	}
 }
@@ -40,5 +47,13 @@ func (pc *PooledDBConnection) Reconnect() error {
 		return err
 	}
 	pc.DBConnection = newConn
+	pc.created = time.Now()
 	return nil
 }
+
+// expired returns true if the connection is older than the
+// max lifetime of its pool.
+func (pc *PooledDBConnection) expired() bool {
+	maxLifetime := pc.pool.MaxLifetime()
+	return maxLifetime > 0 && time.Now().Sub(pc.created) > maxLifetime
+}
@@ -66,6 +66,8 @@ It has these top-level messages:
 	StreamHealthResponse
 	UpdateStreamRequest
 	UpdateStreamResponse
+	UpdateStreamAckRequest
+	UpdateStreamAckResponse
 	TransactionMetadata
 */
 package query
@@ -1094,6 +1096,12 @@ type StreamEvent_Statement struct {
 	// sql is set for all queries.
 	// FIXME(alainjobart) we may not need it for DMLs.
 	Sql []byte `protobuf:"bytes,5,opt,name=sql,proto3" json:"sql,omitempty"`
+	// column_names and column_values are set for DMLs from row-based
+	// replication: the non-PK columns written by an insert, or changed
+	// by an update, and their new values. They're empty for
+	// statement-based replication and for deletes.
+	ColumnNames  []*Field `protobuf:"bytes,6,rep,name=column_names,json=columnNames" json:"column_names,omitempty"`
+	ColumnValues []*Row   `protobuf:"bytes,7,rep,name=column_values,json=columnValues" json:"column_values,omitempty"`
 }
 
 func (m *StreamEvent_Statement) Reset()                    { *m = StreamEvent_Statement{} }
@@ -1136,6 +1144,20 @@ func (m *StreamEvent_Statement) GetSql() []byte {
 	return nil
 }
 
+func (m *StreamEvent_Statement) GetColumnNames() []*Field {
+	if m != nil {
+		return m.ColumnNames
+	}
+	return nil
+}
+
+func (m *StreamEvent_Statement) GetColumnValues() []*Row {
+	if m != nil {
+		return m.ColumnValues
+	}
+	return nil
+}
+
 // ExecuteRequest is the payload to Execute
 type ExecuteRequest struct {
 	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId" json:"effective_caller_id,omitempty"`
@@ -2738,6 +2760,68 @@ func (m *UpdateStreamResponse) GetEvent() *StreamEvent {
 	return nil
 }
 
+// UpdateStreamAckRequest is the payload for UpdateStreamAck. A consumer
+// of UpdateStream sends it to report how far it has applied the stream.
+type UpdateStreamAckRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target" json:"target,omitempty"`
+	// consumer identifies the UpdateStream consumer. Acks from the same
+	// consumer overwrite each other.
+	Consumer string `protobuf:"bytes,4,opt,name=consumer" json:"consumer,omitempty"`
+	// position is the last replication position the consumer applied.
+	Position string `protobuf:"bytes,5,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *UpdateStreamAckRequest) Reset()                    { *m = UpdateStreamAckRequest{} }
+func (m *UpdateStreamAckRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateStreamAckRequest) ProtoMessage()               {}
+func (*UpdateStreamAckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *UpdateStreamAckRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *UpdateStreamAckRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *UpdateStreamAckRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *UpdateStreamAckRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *UpdateStreamAckRequest) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+// UpdateStreamAckResponse is returned by UpdateStreamAck.
+type UpdateStreamAckResponse struct {
+}
+
+func (m *UpdateStreamAckResponse) Reset()                    { *m = UpdateStreamAckResponse{} }
+func (m *UpdateStreamAckResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateStreamAckResponse) ProtoMessage()               {}
+func (*UpdateStreamAckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
 // TransactionMetadata contains the metadata for a distributed transaction.
 type TransactionMetadata struct {
 	Dtid         string           `protobuf:"bytes,1,opt,name=dtid" json:"dtid,omitempty"`
@@ -2749,7 +2833,7 @@ type TransactionMetadata struct {
 func (m *TransactionMetadata) Reset()                    { *m = TransactionMetadata{} }
 func (m *TransactionMetadata) String() string            { return proto.CompactTextString(m) }
 func (*TransactionMetadata) ProtoMessage()               {}
-func (*TransactionMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*TransactionMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *TransactionMetadata) GetDtid() string {
 	if m != nil {
@@ -2839,6 +2923,8 @@ func init() {
 	proto.RegisterType((*StreamHealthResponse)(nil), "query.StreamHealthResponse")
 	proto.RegisterType((*UpdateStreamRequest)(nil), "query.UpdateStreamRequest")
 	proto.RegisterType((*UpdateStreamResponse)(nil), "query.UpdateStreamResponse")
+	proto.RegisterType((*UpdateStreamAckRequest)(nil), "query.UpdateStreamAckRequest")
+	proto.RegisterType((*UpdateStreamAckResponse)(nil), "query.UpdateStreamAckResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
 	proto.RegisterEnum("query.MySqlFlag", MySqlFlag_name, MySqlFlag_value)
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
@@ -2854,204 +2940,208 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3183 bytes of a gzipped FileDescriptorProto
+	// 3239 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x1b, 0xc7,
-	0x99, 0xd7, 0xe0, 0x41, 0x02, 0x1f, 0x08, 0xb0, 0xd9, 0x20, 0x25, 0x88, 0xf2, 0x83, 0x3b, 0xb6,
-	0x6c, 0x2e, 0xed, 0xe5, 0xca, 0x94, 0x56, 0xab, 0xb5, 0x77, 0xbd, 0x1a, 0x82, 0x43, 0x19, 0x16,
-	0x5e, 0x6a, 0x0c, 0x24, 0xcb, 0xe5, 0xaa, 0xa9, 0x21, 0xd0, 0x02, 0xa7, 0x38, 0xc0, 0x40, 0x33,
-	0x03, 0x51, 0xbc, 0x69, 0xd7, 0x71, 0xde, 0x0f, 0xe7, 0xe9, 0x38, 0xa9, 0x38, 0xa9, 0xca, 0x3d,
-	0x7f, 0x43, 0x2a, 0x7f, 0x40, 0x6e, 0x39, 0x24, 0x39, 0xe4, 0x90, 0x4a, 0xe5, 0x90, 0x2a, 0x57,
-	0x4e, 0x39, 0xe4, 0x90, 0x4a, 0xf5, 0x63, 0x06, 0x03, 0x12, 0x7a, 0x58, 0xc9, 0x85, 0xb2, 0x4f,
-	0xe8, 0xfe, 0xbe, 0xaf, 0x1f, 0xbf, 0xdf, 0xf7, 0xcd, 0xd7, 0x8d, 0xee, 0x86, 0xdc, 0xed, 0x11,
-	0xf5, 0x0e, 0xd6, 0x87, 0x9e, 0x1b, 0xb8, 0x38, 0xcd, 0x2b, 0xcb, 0x85, 0xc0, 0x1d, 0xba, 0x5d,
-	0x2b, 0xb0, 0x84, 0x78, 0x39, 0x77, 0x27, 0xf0, 0x86, 0x1d, 0x51, 0x51, 0xdf, 0x53, 0x60, 0xc6,
-	0xb0, 0xbc, 0x1e, 0x0d, 0xf0, 0x32, 0x64, 0xf6, 0xe8, 0x81, 0x3f, 0xb4, 0x3a, 0xb4, 0xa4, 0xac,
-	0x28, 0xab, 0x59, 0x12, 0xd5, 0xf1, 0x22, 0xa4, 0xfd, 0x5d, 0xcb, 0xeb, 0x96, 0x12, 0x5c, 0x21,
-	0x2a, 0xf8, 0x3f, 0x20, 0x17, 0x58, 0x3b, 0x0e, 0x0d, 0xcc, 0xe0, 0x60, 0x48, 0x4b, 0xc9, 0x15,
-	0x65, 0xb5, 0xb0, 0xb1, 0xb8, 0x1e, 0x8d, 0x67, 0x70, 0xa5, 0x71, 0x30, 0xa4, 0x04, 0x82, 0xa8,
-	0x8c, 0x31, 0xa4, 0x3a, 0xd4, 0x71, 0x4a, 0x29, 0xde, 0x17, 0x2f, 0xab, 0x5b, 0x50, 0xb8, 0x6e,
-	0x5c, 0xb1, 0x02, 0x5a, 0xb6, 0x1c, 0x87, 0x7a, 0x95, 0x2d, 0x36, 0x9d, 0x91, 0x4f, 0xbd, 0x81,
-	0xd5, 0x8f, 0xa6, 0x13, 0xd6, 0xf1, 0x49, 0x98, 0xe9, 0x79, 0xee, 0x68, 0xe8, 0x97, 0x12, 0x2b,
-	0xc9, 0xd5, 0x2c, 0x91, 0x35, 0xf5, 0x1d, 0x00, 0xfd, 0x0e, 0x1d, 0x04, 0x86, 0xbb, 0x47, 0x07,
-	0xf8, 0x29, 0xc8, 0x06, 0x76, 0x9f, 0xfa, 0x81, 0xd5, 0x1f, 0xf2, 0x2e, 0x92, 0x64, 0x2c, 0xb8,
-	0x0f, 0xa4, 0x65, 0xc8, 0x0c, 0x5d, 0xdf, 0x0e, 0x6c, 0x77, 0xc0, 0xf1, 0x64, 0x49, 0x54, 0x57,
-	0x5f, 0x87, 0xf4, 0x75, 0xcb, 0x19, 0x51, 0xfc, 0x2c, 0xa4, 0x38, 0x60, 0x85, 0x03, 0xce, 0xad,
-	0x0b, 0xd2, 0x39, 0x4e, 0xae, 0x60, 0x7d, 0xdf, 0x61, 0x96, 0xbc, 0xef, 0x39, 0x22, 0x2a, 0xea,
-	0x1e, 0xcc, 0x6d, 0xda, 0x83, 0xee, 0x75, 0xcb, 0xb3, 0x19, 0x19, 0x8f, 0xd9, 0x0d, 0x7e, 0x1e,
-	0x66, 0x78, 0xc1, 0x2f, 0x25, 0x57, 0x92, 0xab, 0xb9, 0x8d, 0x39, 0xd9, 0x90, 0xcf, 0x8d, 0x48,
-	0x9d, 0xfa, 0x0b, 0x05, 0x60, 0xd3, 0x1d, 0x0d, 0xba, 0xd7, 0x98, 0x12, 0x23, 0x48, 0xfa, 0xb7,
-	0x1d, 0x49, 0x24, 0x2b, 0xe2, 0xab, 0x50, 0xd8, 0xb1, 0x07, 0x5d, 0xf3, 0x8e, 0x9c, 0x8e, 0xe0,
-	0x32, 0xb7, 0xf1, 0xbc, 0xec, 0x6e, 0xdc, 0x78, 0x3d, 0x3e, 0x6b, 0x5f, 0x1f, 0x04, 0xde, 0x01,
-	0xc9, 0xef, 0xc4, 0x65, 0xcb, 0x6d, 0xc0, 0x47, 0x8d, 0xd8, 0xa0, 0x7b, 0xf4, 0x20, 0x1c, 0x74,
-	0x8f, 0x1e, 0xe0, 0x7f, 0x8d, 0x23, 0xca, 0x6d, 0x14, 0xc3, 0xb1, 0x62, 0x6d, 0x25, 0xcc, 0x57,
-	0x13, 0x97, 0x14, 0xf5, 0x4f, 0x69, 0x28, 0xe8, 0x77, 0x69, 0x67, 0x14, 0xd0, 0xc6, 0x90, 0xf9,
-	0xc0, 0xc7, 0xeb, 0x50, 0xb4, 0x07, 0x1d, 0x67, 0xd4, 0xa5, 0x26, 0x65, 0xae, 0x36, 0x03, 0xe6,
-	0x6b, 0xde, 0x5f, 0x86, 0x2c, 0x48, 0x55, 0x2c, 0x08, 0x34, 0x28, 0x76, 0xdc, 0xfe, 0xd0, 0xf2,
-	0x26, 0xed, 0x93, 0x7c, 0xfc, 0x05, 0x39, 0xfe, 0xd8, 0x9e, 0x2c, 0x48, 0xeb, 0x58, 0x17, 0x35,
-	0x98, 0x97, 0xfd, 0x76, 0xcd, 0x5b, 0x36, 0x75, 0xba, 0x3e, 0x0f, 0xdd, 0x42, 0x44, 0xd5, 0xe4,
-	0x14, 0xd7, 0x2b, 0xd2, 0x78, 0x9b, 0xdb, 0x92, 0x82, 0x3d, 0x51, 0xc7, 0x6b, 0xb0, 0xd0, 0x71,
-	0x6c, 0x36, 0x95, 0x5b, 0x8c, 0x62, 0xd3, 0x73, 0xf7, 0xfd, 0x52, 0x9a, 0xcf, 0x7f, 0x5e, 0x28,
-	0xb6, 0x99, 0x9c, 0xb8, 0xfb, 0x3e, 0x7e, 0x15, 0x32, 0xfb, 0xae, 0xb7, 0xe7, 0xb8, 0x56, 0xb7,
-	0x34, 0xc3, 0xc7, 0x7c, 0x66, 0xfa, 0x98, 0x37, 0xa4, 0x15, 0x89, 0xec, 0xf1, 0x2a, 0x20, 0xff,
-	0xb6, 0x63, 0xfa, 0xd4, 0xa1, 0x9d, 0xc0, 0x74, 0xec, 0xbe, 0x1d, 0x94, 0x32, 0xfc, 0x2b, 0x28,
-	0xf8, 0xb7, 0x9d, 0x16, 0x17, 0x57, 0x99, 0x14, 0x9b, 0xb0, 0x14, 0x78, 0xd6, 0xc0, 0xb7, 0x3a,
-	0xac, 0x33, 0xd3, 0xf6, 0x5d, 0xc7, 0xe2, 0x5f, 0x40, 0x96, 0x0f, 0xb9, 0x36, 0x7d, 0x48, 0x63,
-	0xdc, 0xa4, 0x12, 0xb6, 0x20, 0x8b, 0xc1, 0x14, 0x29, 0x7e, 0x05, 0x96, 0xfc, 0x3d, 0x7b, 0x68,
-	0xf2, 0x7e, 0xcc, 0xa1, 0x63, 0x0d, 0xcc, 0x8e, 0xd5, 0xd9, 0xa5, 0x25, 0xe0, 0xb0, 0x31, 0x53,
-	0xf2, 0x50, 0x6b, 0x3a, 0xd6, 0xa0, 0xcc, 0x34, 0xea, 0x6b, 0x50, 0x98, 0xe4, 0x11, 0x2f, 0x40,
-	0xde, 0xb8, 0xd9, 0xd4, 0x4d, 0xad, 0xbe, 0x65, 0xd6, 0xb5, 0x9a, 0x8e, 0x4e, 0xe0, 0x3c, 0x64,
-	0xb9, 0xa8, 0x51, 0xaf, 0xde, 0x44, 0x0a, 0x9e, 0x85, 0xa4, 0x56, 0xad, 0xa2, 0x84, 0x7a, 0x09,
-	0x32, 0x21, 0x21, 0x78, 0x1e, 0x72, 0xed, 0x7a, 0xab, 0xa9, 0x97, 0x2b, 0xdb, 0x15, 0x7d, 0x0b,
-	0x9d, 0xc0, 0x19, 0x48, 0x35, 0xaa, 0x46, 0x13, 0x29, 0xa2, 0xa4, 0x35, 0x51, 0x82, 0xb5, 0xdc,
-	0xda, 0xd4, 0x50, 0x52, 0x0d, 0x60, 0x71, 0x1a, 0x2e, 0x9c, 0x83, 0xd9, 0x2d, 0x7d, 0x5b, 0x6b,
-	0x57, 0x0d, 0x74, 0x02, 0x17, 0x61, 0x9e, 0xe8, 0x4d, 0x5d, 0x33, 0xb4, 0xcd, 0xaa, 0x6e, 0x12,
-	0x5d, 0xdb, 0x42, 0x0a, 0xc6, 0x50, 0x60, 0x25, 0xb3, 0xdc, 0xa8, 0xd5, 0x2a, 0x86, 0xa1, 0x6f,
-	0xa1, 0x04, 0x5e, 0x04, 0xc4, 0x65, 0xed, 0xfa, 0x58, 0x9a, 0xc4, 0x08, 0xe6, 0x5a, 0x3a, 0xa9,
-	0x68, 0xd5, 0xca, 0xdb, 0xac, 0x03, 0x94, 0x7a, 0x33, 0x95, 0x51, 0x50, 0x42, 0xfd, 0x20, 0x01,
-	0x69, 0x8e, 0x95, 0x65, 0xc8, 0x58, 0xde, 0xe3, 0xe5, 0x28, 0x5b, 0x24, 0x1e, 0x90, 0x2d, 0x78,
-	0x92, 0x95, 0x79, 0x4b, 0x54, 0xf0, 0x19, 0xc8, 0xba, 0x5e, 0xcf, 0x14, 0x1a, 0x91, 0x71, 0x33,
-	0xae, 0xd7, 0xe3, 0xa9, 0x99, 0x65, 0x3b, 0x96, 0xa8, 0x77, 0x2c, 0x9f, 0xf2, 0x08, 0xcc, 0x92,
-	0xa8, 0x8e, 0x4f, 0x03, 0xb3, 0x33, 0xf9, 0x3c, 0x66, 0xb8, 0x6e, 0xd6, 0xf5, 0x7a, 0x75, 0x36,
-	0x95, 0xe7, 0x20, 0xdf, 0x71, 0x9d, 0x51, 0x7f, 0x60, 0x3a, 0x74, 0xd0, 0x0b, 0x76, 0x4b, 0xb3,
-	0x2b, 0xca, 0x6a, 0x9e, 0xcc, 0x09, 0x61, 0x95, 0xcb, 0x70, 0x09, 0x66, 0x3b, 0xbb, 0x96, 0xe7,
-	0x53, 0x11, 0x75, 0x79, 0x12, 0x56, 0xf9, 0xa8, 0xb4, 0x63, 0xf7, 0x2d, 0xc7, 0xe7, 0x11, 0x96,
-	0x27, 0x51, 0x9d, 0x81, 0xb8, 0xe5, 0x58, 0x3d, 0x9f, 0x47, 0x46, 0x9e, 0x88, 0x8a, 0xfa, 0x9f,
-	0x90, 0x24, 0xee, 0x3e, 0xeb, 0x52, 0x0c, 0xe8, 0x97, 0x94, 0x95, 0xe4, 0x2a, 0x26, 0x61, 0x95,
-	0x2d, 0x08, 0x32, 0x27, 0x8a, 0x54, 0x19, 0x66, 0xc1, 0x77, 0x60, 0x8e, 0x50, 0x7f, 0xe4, 0x04,
-	0xfa, 0xdd, 0xc0, 0xb3, 0x7c, 0xbc, 0x01, 0xb9, 0x78, 0x16, 0x50, 0xee, 0x97, 0x05, 0x80, 0x8e,
-	0x3f, 0xff, 0x12, 0xcc, 0xde, 0xf2, 0xa8, 0xbf, 0x4b, 0x3d, 0x99, 0x65, 0xc2, 0x2a, 0xcb, 0xb1,
-	0x39, 0x1e, 0xb6, 0x62, 0x0c, 0x96, 0x99, 0x65, 0x7e, 0x50, 0x26, 0x32, 0x33, 0x77, 0x2a, 0x91,
-	0x3a, 0xc6, 0x1e, 0xfb, 0xe4, 0x4d, 0xeb, 0xd6, 0x2d, 0xda, 0x09, 0xa8, 0x58, 0x80, 0x52, 0x64,
-	0x8e, 0x09, 0x35, 0x29, 0x63, 0x6e, 0xb3, 0x07, 0x3e, 0xf5, 0x02, 0xd3, 0xee, 0x72, 0x87, 0xa6,
-	0x48, 0x46, 0x08, 0x2a, 0x5d, 0xfc, 0x0c, 0xa4, 0x78, 0xd2, 0x48, 0xf1, 0x51, 0x40, 0x8e, 0x42,
-	0xdc, 0x7d, 0xc2, 0xe5, 0xf8, 0x25, 0x98, 0xa1, 0x1c, 0x2f, 0x77, 0xea, 0x38, 0xcd, 0xc6, 0xa9,
-	0x20, 0xd2, 0x44, 0xfd, 0x49, 0x12, 0x72, 0xad, 0xc0, 0xa3, 0x56, 0x9f, 0xe3, 0xc7, 0xff, 0x0d,
-	0xe0, 0x07, 0x56, 0x40, 0xfb, 0x74, 0x10, 0x84, 0x40, 0x9e, 0x92, 0x1d, 0xc4, 0xec, 0xd6, 0x5b,
-	0xa1, 0x11, 0x89, 0xd9, 0x1f, 0x26, 0x38, 0xf1, 0x08, 0x04, 0x2f, 0x7f, 0x94, 0x80, 0x6c, 0xd4,
-	0x1b, 0xd6, 0x20, 0xd3, 0xb1, 0x02, 0xda, 0x73, 0xbd, 0x03, 0xb9, 0x32, 0x9e, 0x7d, 0xd0, 0xe8,
-	0xeb, 0x65, 0x69, 0x4c, 0xa2, 0x66, 0xf8, 0x69, 0x10, 0xdb, 0x0d, 0x11, 0xbc, 0x62, 0x7d, 0xcf,
-	0x72, 0x09, 0x0f, 0xdf, 0x57, 0x01, 0x0f, 0x3d, 0xbb, 0x6f, 0x79, 0x07, 0xe6, 0x1e, 0x3d, 0x08,
-	0x53, 0x7a, 0x72, 0x8a, 0xcb, 0x90, 0xb4, 0xbb, 0x4a, 0x0f, 0x64, 0x12, 0xba, 0x34, 0xd9, 0x56,
-	0x06, 0xdd, 0x51, 0x47, 0xc4, 0x5a, 0xf2, 0x75, 0xd9, 0x0f, 0x57, 0xe0, 0x34, 0x8f, 0x4f, 0x56,
-	0x54, 0x5f, 0x84, 0x4c, 0x38, 0x79, 0x9c, 0x85, 0xb4, 0xee, 0x79, 0xae, 0x87, 0x4e, 0xf0, 0x5c,
-	0x54, 0xab, 0x8a, 0x74, 0xb6, 0xb5, 0xc5, 0xd2, 0xd9, 0xcf, 0x13, 0xd1, 0x32, 0x48, 0xe8, 0xed,
-	0x11, 0xf5, 0x03, 0xfc, 0xbf, 0x50, 0xa4, 0x3c, 0x56, 0xec, 0x3b, 0xd4, 0xec, 0xf0, 0x3d, 0x13,
-	0x8b, 0x14, 0x11, 0xd0, 0xf3, 0xeb, 0x62, 0x8b, 0x17, 0xee, 0xa5, 0xc8, 0x42, 0x64, 0x2b, 0x45,
-	0x5d, 0xac, 0x43, 0xd1, 0xee, 0xf7, 0x69, 0xd7, 0xb6, 0x82, 0x78, 0x07, 0xc2, 0x61, 0x4b, 0xe1,
-	0x96, 0x62, 0x62, 0x4b, 0x46, 0x16, 0xa2, 0x16, 0x51, 0x37, 0x67, 0x61, 0x26, 0xe0, 0xdb, 0x47,
-	0xb9, 0xa2, 0xe6, 0xc3, 0xbc, 0xc4, 0x85, 0x44, 0x2a, 0xf1, 0x8b, 0x20, 0x36, 0xa3, 0x3c, 0x03,
-	0x8d, 0x03, 0x62, 0xbc, 0xc7, 0x20, 0x42, 0x8f, 0xcf, 0x42, 0x61, 0x62, 0x29, 0xea, 0x72, 0xc2,
-	0x92, 0x24, 0x1f, 0x5f, 0x57, 0xba, 0xf8, 0xdf, 0x61, 0xd6, 0x15, 0xcb, 0x10, 0xcf, 0x4d, 0xe3,
-	0x19, 0x4f, 0xae, 0x51, 0x24, 0xb4, 0x52, 0xff, 0x07, 0xe6, 0x23, 0x06, 0xfd, 0xa1, 0x3b, 0xf0,
-	0x29, 0x5e, 0x83, 0x19, 0x8f, 0x7f, 0x10, 0x92, 0x35, 0x2c, 0xbb, 0x88, 0x7d, 0xd1, 0x44, 0x5a,
-	0xa8, 0x5d, 0x98, 0x17, 0x92, 0x1b, 0x76, 0xb0, 0xcb, 0x1d, 0x85, 0xcf, 0x42, 0x9a, 0xb2, 0xc2,
-	0x21, 0xce, 0x49, 0xb3, 0xcc, 0xf5, 0x44, 0x68, 0x63, 0xa3, 0x24, 0x1e, 0x3a, 0xca, 0x9f, 0x13,
-	0x50, 0x94, 0xb3, 0xdc, 0xb4, 0x82, 0xce, 0xee, 0x31, 0x75, 0xf6, 0x4b, 0x30, 0xcb, 0xe4, 0x76,
-	0xf4, 0x61, 0x4c, 0x71, 0x77, 0x68, 0xc1, 0x1c, 0x6e, 0xf9, 0x66, 0xcc, 0xbb, 0x72, 0x2b, 0x94,
-	0xb7, 0xfc, 0xd8, 0x42, 0x3c, 0x25, 0x2e, 0x66, 0x1e, 0x12, 0x17, 0xb3, 0x8f, 0x14, 0x17, 0x5b,
-	0xb0, 0x38, 0xc9, 0xb8, 0x0c, 0x8e, 0x97, 0x61, 0x56, 0x38, 0x25, 0x4c, 0x81, 0xd3, 0xfc, 0x16,
-	0x9a, 0xa8, 0x3f, 0x4e, 0xc0, 0xa2, 0xcc, 0x4e, 0x9f, 0x8e, 0xcf, 0x34, 0xc6, 0x73, 0xfa, 0x91,
-	0x78, 0x2e, 0xc3, 0xd2, 0x21, 0x82, 0x1e, 0xe3, 0x2b, 0xfc, 0x58, 0x81, 0xb9, 0x4d, 0xda, 0xb3,
-	0x07, 0xc7, 0x94, 0xde, 0x18, 0x6b, 0xa9, 0x47, 0x62, 0xed, 0x22, 0xe4, 0x25, 0x5e, 0xc9, 0xd6,
-	0xd1, 0xcf, 0x40, 0x99, 0xf2, 0x19, 0xa8, 0x7f, 0x50, 0x20, 0x5f, 0x76, 0xfb, 0x7d, 0x3b, 0x38,
-	0xa6, 0x4c, 0x1d, 0xc5, 0x99, 0x9a, 0x86, 0x13, 0x41, 0x21, 0x84, 0x29, 0x08, 0x52, 0xff, 0xa8,
-	0xc0, 0x3c, 0x71, 0x1d, 0x67, 0xc7, 0xea, 0xec, 0x3d, 0xd9, 0xd8, 0x31, 0xa0, 0x31, 0x50, 0x89,
-	0xfe, 0xaf, 0x0a, 0x14, 0x9a, 0x1e, 0x65, 0xff, 0x5f, 0x9f, 0x68, 0xf0, 0xec, 0x0f, 0x52, 0x37,
-	0x90, 0x9b, 0x83, 0x2c, 0xe1, 0x65, 0x75, 0x01, 0xe6, 0x23, 0xec, 0x92, 0x8f, 0xdf, 0x28, 0xb0,
-	0x24, 0x02, 0x44, 0x6a, 0xba, 0xc7, 0x94, 0x96, 0x10, 0x6f, 0x2a, 0x86, 0xb7, 0x04, 0x27, 0x0f,
-	0x63, 0x93, 0xb0, 0xdf, 0x4d, 0xc0, 0xa9, 0x30, 0x36, 0x8e, 0x39, 0xf0, 0x7f, 0x20, 0x1e, 0x96,
-	0xa1, 0x74, 0x94, 0x04, 0xc9, 0xd0, 0xfb, 0x09, 0x28, 0x95, 0x3d, 0x6a, 0x05, 0x34, 0xb6, 0xc9,
-	0x78, 0x72, 0x62, 0x03, 0xbf, 0x02, 0x73, 0x43, 0xcb, 0x0b, 0xec, 0x8e, 0x3d, 0xb4, 0xd8, 0xdf,
-	0xb8, 0x34, 0xdf, 0xc3, 0x1c, 0xea, 0x60, 0xc2, 0x44, 0x3d, 0x03, 0xa7, 0xa7, 0x30, 0x22, 0xf9,
-	0xfa, 0x9b, 0x02, 0xb8, 0x15, 0x58, 0x5e, 0xf0, 0x29, 0x58, 0x55, 0xa6, 0x06, 0xd3, 0x12, 0x14,
-	0x27, 0xf0, 0xc7, 0x79, 0xa1, 0xc1, 0xa7, 0x62, 0xc5, 0xb9, 0x2f, 0x2f, 0x71, 0xfc, 0x92, 0x97,
-	0xdf, 0x29, 0xb0, 0x5c, 0x76, 0xc5, 0xf9, 0xdd, 0x13, 0xf9, 0x85, 0xa9, 0x4f, 0xc3, 0x99, 0xa9,
-	0x00, 0x25, 0x01, 0xbf, 0x55, 0xe0, 0x24, 0xa1, 0x56, 0xf7, 0xc9, 0x04, 0x7f, 0x0d, 0x4e, 0x1d,
-	0x01, 0x27, 0x77, 0xa8, 0x17, 0x21, 0xd3, 0xa7, 0x81, 0xd5, 0xb5, 0x02, 0x4b, 0x42, 0x5a, 0x0e,
-	0xfb, 0x1d, 0x5b, 0xd7, 0xa4, 0x05, 0x89, 0x6c, 0xd5, 0x8f, 0x12, 0x50, 0xe4, 0x7b, 0xdd, 0xcf,
-	0xfe, 0x41, 0x4d, 0xff, 0x2f, 0xf0, 0xbe, 0x02, 0x8b, 0x93, 0x04, 0x45, 0xff, 0x09, 0xfe, 0xd9,
-	0x07, 0x11, 0x53, 0x12, 0x42, 0x72, 0xda, 0x16, 0xf4, 0x97, 0x09, 0x28, 0xc5, 0xa7, 0xf4, 0xd9,
-	0xa1, 0xc5, 0xe4, 0xa1, 0xc5, 0x27, 0x3e, 0xa5, 0xfa, 0x40, 0x81, 0xd3, 0x53, 0x08, 0xfd, 0x64,
-	0x8e, 0x8e, 0x1d, 0x5d, 0x24, 0x1e, 0x7a, 0x74, 0xf1, 0xa8, 0xae, 0xfe, 0xb5, 0x02, 0x8b, 0x35,
-	0xea, 0xfb, 0x56, 0x8f, 0x8a, 0xff, 0xf1, 0xc7, 0x37, 0x9b, 0xf1, 0x43, 0xe1, 0xd4, 0xf8, 0x66,
-	0x45, 0x2d, 0xc3, 0xd2, 0x21, 0x68, 0x8f, 0x71, 0x36, 0xf1, 0x17, 0x05, 0x16, 0x64, 0x2f, 0xda,
-	0xb1, 0xdd, 0x08, 0x4c, 0x61, 0x07, 0x3f, 0x03, 0x49, 0xbb, 0x1b, 0xee, 0x20, 0x27, 0xef, 0x9a,
-	0x99, 0x42, 0xbd, 0x0c, 0x38, 0x8e, 0xfb, 0x31, 0xa8, 0xfb, 0x55, 0x12, 0x16, 0x5a, 0x43, 0xc7,
-	0x0e, 0xa4, 0xf2, 0xc9, 0x4e, 0xfc, 0xff, 0x02, 0x73, 0x3e, 0x03, 0x6b, 0x8a, 0xdb, 0x32, 0x4e,
-	0x6c, 0x96, 0xe4, 0xb8, 0xac, 0xcc, 0x45, 0xf8, 0x59, 0xc8, 0x85, 0x26, 0xa3, 0x41, 0x20, 0x4f,
-	0x3a, 0x41, 0x5a, 0x8c, 0x06, 0x01, 0xbe, 0x00, 0xa7, 0x06, 0xa3, 0x3e, 0xbf, 0x39, 0x36, 0x87,
-	0xd4, 0x0b, 0xef, 0x55, 0x2d, 0x2f, 0xbc, 0xe1, 0x2d, 0x0e, 0x46, 0x7d, 0xe2, 0xee, 0xfb, 0x4d,
-	0xea, 0x89, 0x7b, 0x55, 0xcb, 0x0b, 0xf0, 0x65, 0xc8, 0x5a, 0x4e, 0xcf, 0xf5, 0xec, 0x60, 0xb7,
-	0x2f, 0xaf, 0x76, 0xd5, 0xf0, 0x6a, 0xe5, 0x30, 0xfd, 0xeb, 0x5a, 0x68, 0x49, 0xc6, 0x8d, 0xd4,
-	0x97, 0x21, 0x1b, 0xc9, 0x31, 0x82, 0x39, 0xfd, 0x5a, 0x5b, 0xab, 0x9a, 0xad, 0x66, 0xb5, 0x62,
-	0xb4, 0xc4, 0x75, 0xec, 0x76, 0xbb, 0x5a, 0x35, 0x5b, 0x65, 0xad, 0x8e, 0x14, 0x95, 0x00, 0xf0,
-	0x2e, 0x79, 0xe7, 0x63, 0x82, 0x94, 0x87, 0x10, 0x74, 0x06, 0xb2, 0x9e, 0xbb, 0x2f, 0xb1, 0x27,
-	0x38, 0x9c, 0x8c, 0xe7, 0xee, 0x73, 0xe4, 0xaa, 0x06, 0x38, 0x3e, 0x57, 0x19, 0x6d, 0xb1, 0xe4,
-	0xad, 0x4c, 0x24, 0xef, 0xf1, 0xf8, 0x51, 0xf2, 0x16, 0x5b, 0x79, 0xf6, 0x9d, 0xbf, 0x41, 0x2d,
-	0x27, 0x08, 0xd7, 0x2b, 0xf5, 0xa7, 0x09, 0xc8, 0x13, 0x26, 0xb1, 0xfb, 0xb4, 0x15, 0x58, 0x81,
-	0xcf, 0x3c, 0xb5, 0xcb, 0x4d, 0xcc, 0x71, 0xda, 0xcd, 0x92, 0x9c, 0x90, 0x89, 0x4b, 0x80, 0x0d,
-	0x58, 0xf2, 0x69, 0xc7, 0x1d, 0x74, 0x7d, 0x73, 0x87, 0xee, 0xda, 0x83, 0xae, 0xd9, 0xb7, 0xfc,
-	0x40, 0xde, 0x14, 0xe6, 0x49, 0x51, 0x2a, 0x37, 0xb9, 0xae, 0xc6, 0x55, 0xf8, 0x1c, 0x2c, 0xee,
-	0xd8, 0x03, 0xc7, 0xed, 0x99, 0x43, 0xc7, 0x3a, 0xa0, 0x9e, 0x2f, 0xa1, 0xb2, 0xf0, 0x4a, 0x13,
-	0x2c, 0x74, 0x4d, 0xa1, 0x12, 0xee, 0x7e, 0x1b, 0xd6, 0xa6, 0x8e, 0x62, 0xde, 0xb2, 0x9d, 0x80,
-	0x7a, 0xb4, 0x6b, 0x7a, 0x74, 0xe8, 0xd8, 0x1d, 0x71, 0x69, 0x2f, 0xf6, 0xee, 0x2f, 0x4c, 0x19,
-	0x7a, 0x5b, 0x9a, 0x93, 0xb1, 0x35, 0x63, 0xbb, 0x33, 0x1c, 0x99, 0x23, 0xf6, 0x01, 0xf3, 0x55,
-	0x4c, 0x21, 0x99, 0xce, 0x70, 0xd4, 0x66, 0x75, 0x8c, 0x20, 0x79, 0x7b, 0x28, 0x16, 0x2f, 0x85,
-	0xb0, 0xa2, 0xfa, 0xb1, 0x02, 0x05, 0xad, 0xd7, 0xf3, 0x68, 0xcf, 0x0a, 0x24, 0x4d, 0xe7, 0x60,
-	0x51, 0x50, 0x72, 0x60, 0xca, 0xd7, 0x40, 0x02, 0x8f, 0x22, 0xf0, 0x48, 0x9d, 0x78, 0x0b, 0x14,
-	0x86, 0xef, 0xc9, 0xd1, 0x60, 0x6a, 0x9b, 0x04, 0x6f, 0xb3, 0x18, 0x69, 0xe3, 0xad, 0xfe, 0x0b,
-	0x4e, 0x4f, 0x67, 0xa1, 0x6f, 0x8b, 0xf7, 0x1c, 0x79, 0x72, 0x72, 0x0a, 0xe8, 0x9a, 0x3d, 0x78,
-	0x40, 0x53, 0xeb, 0x2e, 0xe7, 0xeb, 0x3e, 0x4d, 0xad, 0xbb, 0xea, 0xef, 0xa3, 0xa3, 0xfd, 0x30,
-	0x5c, 0xa2, 0xd5, 0x38, 0xcc, 0x0b, 0xca, 0x83, 0xf2, 0x42, 0x09, 0x66, 0x7d, 0xea, 0xdd, 0xb1,
-	0x07, 0xbd, 0xf0, 0xf6, 0x58, 0x56, 0x71, 0x0b, 0x5e, 0x90, 0xd8, 0xe9, 0xdd, 0x80, 0x7a, 0x03,
-	0xcb, 0x71, 0x0e, 0x4c, 0x71, 0x50, 0x31, 0x08, 0x68, 0xd7, 0x1c, 0xbf, 0x5d, 0x12, 0x2b, 0xf2,
-	0x73, 0xc2, 0x5a, 0x8f, 0x8c, 0x49, 0x64, 0x6b, 0x44, 0xaf, 0x9a, 0x5e, 0x83, 0x82, 0x27, 0x83,
-	0xd8, 0xf4, 0x99, 0x7b, 0x64, 0x3e, 0x5a, 0x8c, 0xae, 0x80, 0x63, 0x11, 0x4e, 0xf2, 0xde, 0x44,
-	0xc0, 0xbf, 0x0e, 0xf3, 0x56, 0xe8, 0x5b, 0xd9, 0x7a, 0x72, 0xdf, 0x32, 0xe9, 0x79, 0x52, 0xb0,
-	0x26, 0x23, 0xe1, 0x12, 0xcc, 0x49, 0x44, 0x96, 0x63, 0x5b, 0xe3, 0x8d, 0xed, 0xa1, 0x07, 0x61,
-	0x1a, 0x53, 0x12, 0xf9, 0x74, 0x8c, 0x57, 0xd8, 0xff, 0xe8, 0x62, 0x7b, 0xd8, 0xe5, 0x3d, 0x1d,
-	0xe3, 0xdd, 0x45, 0xfc, 0xf5, 0x58, 0x6a, 0xf2, 0xf5, 0xd8, 0xe4, 0x6b, 0xb4, 0xf4, 0xa1, 0xd7,
-	0x68, 0xea, 0x65, 0x58, 0x9c, 0xc4, 0x2f, 0xa3, 0x6c, 0x15, 0xd2, 0xfc, 0xa6, 0xfc, 0xd0, 0x32,
-	0x1a, 0xbb, 0x0a, 0x27, 0xc2, 0x40, 0xfd, 0x99, 0x02, 0xc5, 0x29, 0x7f, 0xb1, 0xa2, 0xff, 0x6f,
-	0x4a, 0xec, 0x78, 0xe8, 0xdf, 0x20, 0xcd, 0xef, 0xec, 0xe5, 0x63, 0x92, 0x53, 0x47, 0xff, 0xa1,
-	0xf1, 0xfb, 0x75, 0x22, 0xac, 0x58, 0x22, 0xe4, 0x01, 0xd5, 0xe1, 0xe7, 0x43, 0xe1, 0x0e, 0x31,
-	0xc7, 0x64, 0xe2, 0xc8, 0xe8, 0xe8, 0x81, 0x53, 0xea, 0xa1, 0x07, 0x4e, 0x6b, 0xdf, 0x4a, 0x42,
-	0xb6, 0x76, 0xd0, 0xba, 0xed, 0x6c, 0x3b, 0x56, 0x8f, 0x5f, 0x80, 0xd7, 0x9a, 0xc6, 0x4d, 0x74,
-	0x02, 0x2f, 0x40, 0xbe, 0xde, 0x30, 0xcc, 0x3a, 0x5b, 0x4a, 0xb6, 0xab, 0xda, 0x15, 0xa4, 0xb0,
-	0xb5, 0xa6, 0x49, 0x2a, 0xe6, 0x55, 0xfd, 0xa6, 0x90, 0x24, 0x70, 0x11, 0xe6, 0xdb, 0xf5, 0xca,
-	0xb5, 0xb6, 0x3e, 0x16, 0xa6, 0xf0, 0x12, 0x2c, 0xd4, 0xda, 0x55, 0xa3, 0xd2, 0xac, 0xc6, 0xc4,
-	0x19, 0xb6, 0x2e, 0x6d, 0x56, 0x1b, 0x9b, 0xa2, 0x8a, 0x58, 0xff, 0xed, 0x7a, 0xab, 0x72, 0xa5,
-	0xae, 0x6f, 0x09, 0xd1, 0x0a, 0x13, 0xbd, 0xad, 0x93, 0xc6, 0x76, 0x25, 0x1c, 0xf2, 0x32, 0x46,
-	0x90, 0xdb, 0xac, 0xd4, 0x35, 0x22, 0x7b, 0xb9, 0xa7, 0xe0, 0x02, 0x64, 0xf5, 0x7a, 0xbb, 0x26,
-	0xeb, 0x09, 0x5c, 0x82, 0xa2, 0xd6, 0x36, 0x1a, 0x66, 0xa5, 0x5e, 0x26, 0x7a, 0x4d, 0xaf, 0x1b,
-	0x52, 0x93, 0xc2, 0x45, 0x28, 0x18, 0x95, 0x9a, 0xde, 0x32, 0xb4, 0x5a, 0x53, 0x0a, 0xd9, 0x2c,
-	0x32, 0x2d, 0x3d, 0xb4, 0x41, 0x78, 0x19, 0x96, 0xea, 0x0d, 0x53, 0x3e, 0x2a, 0x32, 0xaf, 0x6b,
-	0xd5, 0xb6, 0x2e, 0x75, 0x2b, 0xf8, 0x14, 0xe0, 0x46, 0xdd, 0x6c, 0x37, 0xb7, 0x34, 0x43, 0x37,
-	0xeb, 0x8d, 0x1b, 0x52, 0x71, 0x19, 0x17, 0x20, 0x33, 0x9e, 0xc1, 0x3d, 0xc6, 0x42, 0xbe, 0xa9,
-	0x11, 0x63, 0x0c, 0xf6, 0xde, 0x3d, 0x46, 0x16, 0x5c, 0x21, 0x8d, 0x76, 0x73, 0x6c, 0xb6, 0x00,
-	0x39, 0x49, 0x96, 0x14, 0xa5, 0x98, 0x68, 0xb3, 0x52, 0x2f, 0x47, 0xf3, 0xbb, 0x97, 0x59, 0x4e,
-	0x20, 0x65, 0x6d, 0x0f, 0x52, 0xdc, 0x1d, 0x19, 0x48, 0xd5, 0x1b, 0x75, 0x1d, 0x9d, 0xc0, 0xf3,
-	0x00, 0x95, 0x56, 0xa5, 0x6e, 0xe8, 0x57, 0x88, 0x56, 0x65, 0xb0, 0xb9, 0x20, 0x24, 0x90, 0xa1,
-	0x9d, 0x83, 0xd9, 0x4a, 0x6b, 0xbb, 0xda, 0xd0, 0x0c, 0x09, 0xb3, 0xd2, 0xba, 0xd6, 0x6e, 0x18,
-	0x4c, 0x89, 0x70, 0x0e, 0x66, 0x2a, 0x2d, 0x43, 0x7f, 0xcb, 0x60, 0xb8, 0xb8, 0x4e, 0xb0, 0x8a,
-	0xee, 0x5d, 0x5e, 0xfb, 0x30, 0x09, 0x29, 0xfe, 0x22, 0x34, 0x0f, 0x59, 0xee, 0x6d, 0xe3, 0x66,
-	0x93, 0x0d, 0x99, 0x85, 0x54, 0xa5, 0x6e, 0x5c, 0x42, 0xff, 0x97, 0xc0, 0x00, 0xe9, 0x36, 0x2f,
-	0xff, 0xff, 0x0c, 0x2b, 0x57, 0xea, 0xc6, 0x2b, 0x17, 0xd1, 0xbb, 0x09, 0xd6, 0x6d, 0x5b, 0x54,
-	0x3e, 0x17, 0x2a, 0x36, 0x2e, 0xa0, 0xf7, 0x22, 0xc5, 0xc6, 0x05, 0xf4, 0xf9, 0x50, 0x71, 0x7e,
-	0x03, 0x7d, 0x21, 0x52, 0x9c, 0xdf, 0x40, 0x5f, 0x0c, 0x15, 0x17, 0x2f, 0xa0, 0x2f, 0x45, 0x8a,
-	0x8b, 0x17, 0xd0, 0x97, 0x67, 0x18, 0x16, 0x8e, 0xe4, 0xfc, 0x06, 0xfa, 0x4a, 0x26, 0xaa, 0x5d,
-	0xbc, 0x80, 0xbe, 0x9a, 0x61, 0xfe, 0x8f, 0xbc, 0x8a, 0xbe, 0x86, 0xd8, 0x34, 0x99, 0x83, 0xd0,
-	0xd7, 0x79, 0x91, 0xa9, 0xd0, 0x37, 0x10, 0xc3, 0xc8, 0xa4, 0xbc, 0xfa, 0x3e, 0xd7, 0xdc, 0xd4,
-	0x35, 0x82, 0xbe, 0x39, 0x23, 0xde, 0x90, 0x95, 0x2b, 0x35, 0xad, 0x8a, 0x30, 0x6f, 0xc1, 0x58,
-	0xf9, 0xf6, 0x39, 0x56, 0x64, 0xe1, 0x89, 0xbe, 0xd3, 0x64, 0x03, 0x5e, 0xd7, 0x48, 0xf9, 0x0d,
-	0x8d, 0xa0, 0xef, 0x9e, 0x63, 0x03, 0x5e, 0xd7, 0x88, 0xe4, 0xeb, 0x7b, 0x4d, 0x66, 0xc8, 0x55,
-	0x1f, 0x9c, 0x63, 0x93, 0x96, 0xf2, 0xef, 0x37, 0x71, 0x06, 0x92, 0x9b, 0x15, 0x03, 0x7d, 0xc8,
-	0x47, 0x63, 0x21, 0x8a, 0x7e, 0x80, 0x98, 0xb0, 0xa5, 0x1b, 0xe8, 0x87, 0x4c, 0x98, 0x36, 0xda,
-	0xcd, 0xaa, 0x8e, 0x9e, 0x62, 0x93, 0xbb, 0xa2, 0x37, 0x6a, 0xba, 0x41, 0x6e, 0xa2, 0x1f, 0x71,
-	0xf3, 0x37, 0x5b, 0x8d, 0x3a, 0xfa, 0x08, 0xe1, 0x02, 0x80, 0xfe, 0x56, 0x93, 0xe8, 0xad, 0x56,
-	0xa5, 0x51, 0x47, 0xcf, 0xae, 0x6d, 0x03, 0x3a, 0x9c, 0x0e, 0x18, 0x80, 0x76, 0xfd, 0x6a, 0xbd,
-	0x71, 0xa3, 0x8e, 0x4e, 0xb0, 0x4a, 0x93, 0xe8, 0x4d, 0x8d, 0xe8, 0x48, 0xc1, 0x00, 0x33, 0xe2,
-	0x85, 0x1b, 0x4a, 0xe0, 0x39, 0xc8, 0x90, 0x46, 0xb5, 0xba, 0xa9, 0x95, 0xaf, 0xa2, 0xe4, 0xe6,
-	0x02, 0xcc, 0xdb, 0xee, 0xfa, 0x1d, 0x3b, 0xa0, 0xbe, 0x2f, 0xde, 0x1c, 0xef, 0xcc, 0xf0, 0x9f,
-	0xf3, 0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xde, 0xe3, 0xd2, 0x1e, 0xad, 0x2c, 0x00, 0x00,
+	0x99, 0xd7, 0xe0, 0x45, 0xe0, 0x03, 0x01, 0x36, 0x9b, 0xa4, 0x04, 0x51, 0x7e, 0x70, 0xc7, 0x96,
+	0xcd, 0xa5, 0xbd, 0xb4, 0x4c, 0x69, 0xb5, 0x5a, 0x7b, 0xd7, 0xab, 0x21, 0x38, 0x94, 0x61, 0xe1,
+	0xa5, 0xc6, 0x40, 0xb2, 0x5c, 0xae, 0x9a, 0x1a, 0x02, 0x2d, 0x70, 0x8a, 0x03, 0x0c, 0x34, 0x33,
+	0x90, 0xc4, 0x1b, 0xd7, 0x5e, 0xef, 0x6e, 0xde, 0xce, 0xd3, 0x71, 0x52, 0x71, 0x0e, 0xb9, 0xe7,
+	0x6f, 0x48, 0xe5, 0x0f, 0xc8, 0x2d, 0x87, 0x24, 0x87, 0x1c, 0x52, 0xa9, 0x1c, 0x52, 0x95, 0xca,
+	0x29, 0x87, 0x1c, 0x92, 0x54, 0x3f, 0x66, 0x30, 0x20, 0xa1, 0x87, 0x95, 0x5c, 0x28, 0xfb, 0x84,
+	0xfe, 0x1e, 0xfd, 0x7d, 0xfd, 0xfd, 0xfa, 0x9b, 0xaf, 0x1b, 0xdd, 0x0d, 0xf9, 0xdb, 0x23, 0xea,
+	0xed, 0xaf, 0x0f, 0x3d, 0x37, 0x70, 0x71, 0x9a, 0x13, 0xcb, 0xc5, 0xc0, 0x1d, 0xba, 0x5d, 0x2b,
+	0xb0, 0x04, 0x7b, 0x39, 0x7f, 0x27, 0xf0, 0x86, 0x1d, 0x41, 0xa8, 0x1f, 0x28, 0x90, 0x31, 0x2c,
+	0xaf, 0x47, 0x03, 0xbc, 0x0c, 0xd9, 0x3d, 0xba, 0xef, 0x0f, 0xad, 0x0e, 0x2d, 0x29, 0x2b, 0xca,
+	0x6a, 0x8e, 0x44, 0x34, 0x5e, 0x84, 0xb4, 0xbf, 0x6b, 0x79, 0xdd, 0x52, 0x82, 0x0b, 0x04, 0x81,
+	0xff, 0x15, 0xf2, 0x81, 0xb5, 0xe3, 0xd0, 0xc0, 0x0c, 0xf6, 0x87, 0xb4, 0x94, 0x5c, 0x51, 0x56,
+	0x8b, 0x1b, 0x8b, 0xeb, 0x91, 0x3f, 0x83, 0x0b, 0x8d, 0xfd, 0x21, 0x25, 0x10, 0x44, 0x6d, 0x8c,
+	0x21, 0xd5, 0xa1, 0x8e, 0x53, 0x4a, 0x71, 0x5b, 0xbc, 0xad, 0x6e, 0x41, 0xf1, 0xba, 0x71, 0xc5,
+	0x0a, 0x68, 0xd9, 0x72, 0x1c, 0xea, 0x55, 0xb6, 0xd8, 0x70, 0x46, 0x3e, 0xf5, 0x06, 0x56, 0x3f,
+	0x1a, 0x4e, 0x48, 0xe3, 0x93, 0x90, 0xe9, 0x79, 0xee, 0x68, 0xe8, 0x97, 0x12, 0x2b, 0xc9, 0xd5,
+	0x1c, 0x91, 0x94, 0xfa, 0x2e, 0x80, 0x7e, 0x87, 0x0e, 0x02, 0xc3, 0xdd, 0xa3, 0x03, 0xfc, 0x14,
+	0xe4, 0x02, 0xbb, 0x4f, 0xfd, 0xc0, 0xea, 0x0f, 0xb9, 0x89, 0x24, 0x19, 0x33, 0xee, 0x13, 0xd2,
+	0x32, 0x64, 0x87, 0xae, 0x6f, 0x07, 0xb6, 0x3b, 0xe0, 0xf1, 0xe4, 0x48, 0x44, 0xab, 0x6f, 0x40,
+	0xfa, 0xba, 0xe5, 0x8c, 0x28, 0x7e, 0x16, 0x52, 0x3c, 0x60, 0x85, 0x07, 0x9c, 0x5f, 0x17, 0xa0,
+	0xf3, 0x38, 0xb9, 0x80, 0xd9, 0xbe, 0xc3, 0x34, 0xb9, 0xed, 0x59, 0x22, 0x08, 0x75, 0x0f, 0x66,
+	0x37, 0xed, 0x41, 0xf7, 0xba, 0xe5, 0xd9, 0x0c, 0x8c, 0xc7, 0x34, 0x83, 0x9f, 0x87, 0x0c, 0x6f,
+	0xf8, 0xa5, 0xe4, 0x4a, 0x72, 0x35, 0xbf, 0x31, 0x2b, 0x3b, 0xf2, 0xb1, 0x11, 0x29, 0x53, 0x7f,
+	0xaa, 0x00, 0x6c, 0xba, 0xa3, 0x41, 0xf7, 0x1a, 0x13, 0x62, 0x04, 0x49, 0xff, 0xb6, 0x23, 0x81,
+	0x64, 0x4d, 0x7c, 0x15, 0x8a, 0x3b, 0xf6, 0xa0, 0x6b, 0xde, 0x91, 0xc3, 0x11, 0x58, 0xe6, 0x37,
+	0x9e, 0x97, 0xe6, 0xc6, 0x9d, 0xd7, 0xe3, 0xa3, 0xf6, 0xf5, 0x41, 0xe0, 0xed, 0x93, 0xc2, 0x4e,
+	0x9c, 0xb7, 0xdc, 0x06, 0x7c, 0x54, 0x89, 0x39, 0xdd, 0xa3, 0xfb, 0xa1, 0xd3, 0x3d, 0xba, 0x8f,
+	0xff, 0x39, 0x1e, 0x51, 0x7e, 0x63, 0x21, 0xf4, 0x15, 0xeb, 0x2b, 0xc3, 0x7c, 0x2d, 0x71, 0x49,
+	0x51, 0x7f, 0x9f, 0x86, 0xa2, 0x7e, 0x8f, 0x76, 0x46, 0x01, 0x6d, 0x0c, 0xd9, 0x1c, 0xf8, 0x78,
+	0x1d, 0x16, 0xec, 0x41, 0xc7, 0x19, 0x75, 0xa9, 0x49, 0xd9, 0x54, 0x9b, 0x01, 0x9b, 0x6b, 0x6e,
+	0x2f, 0x4b, 0xe6, 0xa5, 0x28, 0x96, 0x04, 0x1a, 0x2c, 0x74, 0xdc, 0xfe, 0xd0, 0xf2, 0x26, 0xf5,
+	0x93, 0xdc, 0xff, 0xbc, 0xf4, 0x3f, 0xd6, 0x27, 0xf3, 0x52, 0x3b, 0x66, 0xa2, 0x06, 0x73, 0xd2,
+	0x6e, 0xd7, 0xbc, 0x65, 0x53, 0xa7, 0xeb, 0xf3, 0xd4, 0x2d, 0x46, 0x50, 0x4d, 0x0e, 0x71, 0xbd,
+	0x22, 0x95, 0xb7, 0xb9, 0x2e, 0x29, 0xda, 0x13, 0x34, 0x5e, 0x83, 0xf9, 0x8e, 0x63, 0xb3, 0xa1,
+	0xdc, 0x62, 0x10, 0x9b, 0x9e, 0x7b, 0xd7, 0x2f, 0xa5, 0xf9, 0xf8, 0xe7, 0x84, 0x60, 0x9b, 0xf1,
+	0x89, 0x7b, 0xd7, 0xc7, 0xaf, 0x41, 0xf6, 0xae, 0xeb, 0xed, 0x39, 0xae, 0xd5, 0x2d, 0x65, 0xb8,
+	0xcf, 0x67, 0xa6, 0xfb, 0xbc, 0x21, 0xb5, 0x48, 0xa4, 0x8f, 0x57, 0x01, 0xf9, 0xb7, 0x1d, 0xd3,
+	0xa7, 0x0e, 0xed, 0x04, 0xa6, 0x63, 0xf7, 0xed, 0xa0, 0x94, 0xe5, 0x5f, 0x41, 0xd1, 0xbf, 0xed,
+	0xb4, 0x38, 0xbb, 0xca, 0xb8, 0xd8, 0x84, 0xa5, 0xc0, 0xb3, 0x06, 0xbe, 0xd5, 0x61, 0xc6, 0x4c,
+	0xdb, 0x77, 0x1d, 0x8b, 0xb5, 0x4a, 0x39, 0xee, 0x72, 0x6d, 0xba, 0x4b, 0x63, 0xdc, 0xa5, 0x12,
+	0xf6, 0x20, 0x8b, 0xc1, 0x14, 0x2e, 0x7e, 0x15, 0x96, 0xfc, 0x3d, 0x7b, 0x68, 0x72, 0x3b, 0xe6,
+	0xd0, 0xb1, 0x06, 0x66, 0xc7, 0xea, 0xec, 0xd2, 0x12, 0xf0, 0xb0, 0x31, 0x13, 0xf2, 0x54, 0x6b,
+	0x3a, 0xd6, 0xa0, 0xcc, 0x24, 0xea, 0xeb, 0x50, 0x9c, 0xc4, 0x11, 0xcf, 0x43, 0xc1, 0xb8, 0xd9,
+	0xd4, 0x4d, 0xad, 0xbe, 0x65, 0xd6, 0xb5, 0x9a, 0x8e, 0x4e, 0xe0, 0x02, 0xe4, 0x38, 0xab, 0x51,
+	0xaf, 0xde, 0x44, 0x0a, 0x9e, 0x81, 0xa4, 0x56, 0xad, 0xa2, 0x84, 0x7a, 0x09, 0xb2, 0x21, 0x20,
+	0x78, 0x0e, 0xf2, 0xed, 0x7a, 0xab, 0xa9, 0x97, 0x2b, 0xdb, 0x15, 0x7d, 0x0b, 0x9d, 0xc0, 0x59,
+	0x48, 0x35, 0xaa, 0x46, 0x13, 0x29, 0xa2, 0xa5, 0x35, 0x51, 0x82, 0xf5, 0xdc, 0xda, 0xd4, 0x50,
+	0x52, 0x0d, 0x60, 0x71, 0x5a, 0x5c, 0x38, 0x0f, 0x33, 0x5b, 0xfa, 0xb6, 0xd6, 0xae, 0x1a, 0xe8,
+	0x04, 0x5e, 0x80, 0x39, 0xa2, 0x37, 0x75, 0xcd, 0xd0, 0x36, 0xab, 0xba, 0x49, 0x74, 0x6d, 0x0b,
+	0x29, 0x18, 0x43, 0x91, 0xb5, 0xcc, 0x72, 0xa3, 0x56, 0xab, 0x18, 0x86, 0xbe, 0x85, 0x12, 0x78,
+	0x11, 0x10, 0xe7, 0xb5, 0xeb, 0x63, 0x6e, 0x12, 0x23, 0x98, 0x6d, 0xe9, 0xa4, 0xa2, 0x55, 0x2b,
+	0xef, 0x30, 0x03, 0x28, 0xf5, 0x56, 0x2a, 0xab, 0xa0, 0x84, 0xfa, 0x51, 0x02, 0xd2, 0x3c, 0x56,
+	0x56, 0x21, 0x63, 0x75, 0x8f, 0xb7, 0xa3, 0x6a, 0x91, 0x78, 0x40, 0xb5, 0xe0, 0x45, 0x56, 0xd6,
+	0x2d, 0x41, 0xe0, 0x33, 0x90, 0x73, 0xbd, 0x9e, 0x29, 0x24, 0xa2, 0xe2, 0x66, 0x5d, 0xaf, 0xc7,
+	0x4b, 0x33, 0xab, 0x76, 0xac, 0x50, 0xef, 0x58, 0x3e, 0xe5, 0x19, 0x98, 0x23, 0x11, 0x8d, 0x4f,
+	0x03, 0xd3, 0x33, 0xf9, 0x38, 0x32, 0x5c, 0x36, 0xe3, 0x7a, 0xbd, 0x3a, 0x1b, 0xca, 0x73, 0x50,
+	0xe8, 0xb8, 0xce, 0xa8, 0x3f, 0x30, 0x1d, 0x3a, 0xe8, 0x05, 0xbb, 0xa5, 0x99, 0x15, 0x65, 0xb5,
+	0x40, 0x66, 0x05, 0xb3, 0xca, 0x79, 0xb8, 0x04, 0x33, 0x9d, 0x5d, 0xcb, 0xf3, 0xa9, 0xc8, 0xba,
+	0x02, 0x09, 0x49, 0xee, 0x95, 0x76, 0xec, 0xbe, 0xe5, 0xf8, 0x3c, 0xc3, 0x0a, 0x24, 0xa2, 0x59,
+	0x10, 0xb7, 0x1c, 0xab, 0xe7, 0xf3, 0xcc, 0x28, 0x10, 0x41, 0xa8, 0xff, 0x06, 0x49, 0xe2, 0xde,
+	0x65, 0x26, 0x85, 0x43, 0xbf, 0xa4, 0xac, 0x24, 0x57, 0x31, 0x09, 0x49, 0xb6, 0x20, 0xc8, 0x9a,
+	0x28, 0x4a, 0xa5, 0xa4, 0xd4, 0x77, 0x61, 0x96, 0x50, 0x7f, 0xe4, 0x04, 0xfa, 0xbd, 0xc0, 0xb3,
+	0x7c, 0xbc, 0x01, 0xf9, 0x78, 0x15, 0x50, 0xee, 0x57, 0x05, 0x80, 0x46, 0x6d, 0xe6, 0xf5, 0x96,
+	0x47, 0xfd, 0x5d, 0xea, 0xc9, 0x2a, 0x13, 0x92, 0xac, 0xc6, 0xe6, 0x79, 0xda, 0x0a, 0x1f, 0xac,
+	0x32, 0xcb, 0xfa, 0xa0, 0x4c, 0x54, 0x66, 0x3e, 0xa9, 0x44, 0xca, 0x18, 0x7a, 0xec, 0x93, 0x37,
+	0xad, 0x5b, 0xb7, 0x68, 0x27, 0xa0, 0x62, 0x01, 0x4a, 0x91, 0x59, 0xc6, 0xd4, 0x24, 0x8f, 0x4d,
+	0x9b, 0x3d, 0xf0, 0xa9, 0x17, 0x98, 0x76, 0x97, 0x4f, 0x68, 0x8a, 0x64, 0x05, 0xa3, 0xd2, 0xc5,
+	0xcf, 0x40, 0x8a, 0x17, 0x8d, 0x14, 0xf7, 0x02, 0xd2, 0x0b, 0x71, 0xef, 0x12, 0xce, 0xc7, 0x2f,
+	0x41, 0x86, 0xf2, 0x78, 0x4b, 0xe9, 0x89, 0x32, 0x1b, 0x87, 0x82, 0x48, 0x15, 0xf5, 0xbd, 0x14,
+	0xe4, 0x5b, 0x81, 0x47, 0xad, 0x3e, 0x8f, 0x1f, 0xff, 0x07, 0x80, 0x1f, 0x58, 0x01, 0xed, 0xd3,
+	0x41, 0x10, 0x06, 0xf2, 0x94, 0x34, 0x10, 0xd3, 0x5b, 0x6f, 0x85, 0x4a, 0x24, 0xa6, 0x7f, 0x18,
+	0xe0, 0xc4, 0x23, 0x00, 0xbc, 0x7c, 0x90, 0x84, 0x5c, 0x64, 0x0d, 0x6b, 0x90, 0xed, 0x58, 0x01,
+	0xed, 0xb9, 0xde, 0xbe, 0x5c, 0x19, 0xcf, 0x3e, 0xc8, 0xfb, 0x7a, 0x59, 0x2a, 0x93, 0xa8, 0x1b,
+	0x7e, 0x1a, 0xc4, 0x76, 0x43, 0x24, 0xaf, 0x58, 0xdf, 0x73, 0x9c, 0xc3, 0xd3, 0xf7, 0x35, 0xc0,
+	0x43, 0xcf, 0xee, 0x5b, 0xde, 0xbe, 0xb9, 0x47, 0xf7, 0xc3, 0x92, 0x9e, 0x9c, 0x32, 0x65, 0x48,
+	0xea, 0x5d, 0xa5, 0xfb, 0xb2, 0x08, 0x5d, 0x9a, 0xec, 0x2b, 0x93, 0xee, 0xe8, 0x44, 0xc4, 0x7a,
+	0xf2, 0x75, 0xd9, 0x0f, 0x57, 0xe0, 0x34, 0xcf, 0x4f, 0xd6, 0xc4, 0xaf, 0x80, 0xfc, 0x62, 0xf8,
+	0x38, 0xfd, 0x52, 0x66, 0xca, 0x08, 0xf2, 0x42, 0x83, 0x8d, 0xdb, 0xc7, 0xaf, 0x44, 0xdf, 0x9d,
+	0xf4, 0x3b, 0x73, 0xc4, 0xaf, 0xb4, 0x28, 0x7c, 0xaa, 0x2f, 0x42, 0x36, 0x84, 0x07, 0xe7, 0x20,
+	0xad, 0x7b, 0x9e, 0xeb, 0xa1, 0x13, 0xbc, 0xda, 0xd5, 0xaa, 0xa2, 0x60, 0x6e, 0x6d, 0xb1, 0x82,
+	0xf9, 0x93, 0x44, 0xb4, 0xd0, 0x12, 0x7a, 0x7b, 0x44, 0xfd, 0x00, 0xff, 0x17, 0x2c, 0x50, 0x9e,
+	0x8d, 0xf6, 0x1d, 0x6a, 0x76, 0xf8, 0xae, 0x8c, 0xe5, 0xa2, 0xf8, 0x64, 0xe6, 0xd6, 0xc5, 0x26,
+	0x32, 0xdc, 0xad, 0x91, 0xf9, 0x48, 0x57, 0xb2, 0xba, 0x58, 0x87, 0x05, 0xbb, 0xdf, 0xa7, 0x5d,
+	0xdb, 0x0a, 0xe2, 0x06, 0x44, 0x4a, 0x2c, 0x85, 0x9b, 0x96, 0x89, 0x4d, 0x1f, 0x99, 0x8f, 0x7a,
+	0x44, 0x66, 0xce, 0x42, 0x26, 0xe0, 0x1b, 0x54, 0xb9, 0x66, 0x17, 0xc2, 0xca, 0xc7, 0x99, 0x44,
+	0x0a, 0xf1, 0x8b, 0x20, 0xb6, 0xbb, 0xbc, 0xc6, 0x8d, 0x53, 0x6e, 0xbc, 0x8b, 0x21, 0x42, 0x8e,
+	0xcf, 0x42, 0x71, 0x62, 0xb1, 0xeb, 0xf2, 0x29, 0x49, 0x92, 0x42, 0x8c, 0x5b, 0xe9, 0xe2, 0x57,
+	0x60, 0xc6, 0x15, 0x0b, 0x5d, 0x29, 0x33, 0x31, 0xe2, 0xc9, 0x55, 0x90, 0x84, 0x5a, 0xea, 0x7f,
+	0xc2, 0x5c, 0x84, 0xa0, 0x3f, 0x74, 0x07, 0x3e, 0xc5, 0x6b, 0x90, 0xf1, 0xf8, 0x27, 0x27, 0x51,
+	0xc3, 0xd2, 0x44, 0xac, 0x66, 0x10, 0xa9, 0xa1, 0x76, 0x61, 0x4e, 0x70, 0x6e, 0xd8, 0xc1, 0x2e,
+	0x9f, 0x28, 0x7c, 0x16, 0xd2, 0x94, 0x35, 0x0e, 0x61, 0x4e, 0x9a, 0x65, 0x2e, 0x27, 0x42, 0x1a,
+	0xf3, 0x92, 0x78, 0xa8, 0x97, 0x3f, 0x26, 0x60, 0x41, 0x8e, 0x72, 0xd3, 0x0a, 0x3a, 0xbb, 0xc7,
+	0x74, 0xb2, 0x5f, 0x82, 0x19, 0xc6, 0xb7, 0xa3, 0x4f, 0x6f, 0xca, 0x74, 0x87, 0x1a, 0x6c, 0xc2,
+	0x2d, 0xdf, 0x8c, 0xcd, 0xae, 0xdc, 0x6c, 0x15, 0x2c, 0x3f, 0xb6, 0xd4, 0x4f, 0xc9, 0x8b, 0xcc,
+	0x43, 0xf2, 0x62, 0xe6, 0x91, 0xf2, 0x62, 0x0b, 0x16, 0x27, 0x11, 0x97, 0xc9, 0xf1, 0x32, 0xcc,
+	0x88, 0x49, 0x09, 0x8b, 0xec, 0xb4, 0x79, 0x0b, 0x55, 0xd4, 0x1f, 0x26, 0x60, 0x51, 0xd6, 0xbf,
+	0xcf, 0xc6, 0x67, 0x1a, 0xc3, 0x39, 0xfd, 0x48, 0x38, 0x97, 0x61, 0xe9, 0x10, 0x40, 0x8f, 0xf1,
+	0x15, 0xfe, 0x41, 0x81, 0xd9, 0x4d, 0xda, 0xb3, 0x07, 0xc7, 0x14, 0xde, 0x18, 0x6a, 0xa9, 0x47,
+	0x42, 0xed, 0x22, 0x14, 0x64, 0xbc, 0x12, 0xad, 0xa3, 0x9f, 0x81, 0x32, 0xe5, 0x33, 0x50, 0x7f,
+	0xab, 0x40, 0xa1, 0xec, 0xf6, 0xfb, 0x76, 0x70, 0x4c, 0x91, 0x3a, 0x1a, 0x67, 0x6a, 0x5a, 0x9c,
+	0x08, 0x8a, 0x61, 0x98, 0x02, 0x20, 0xf5, 0x77, 0x0a, 0xcc, 0x11, 0xd7, 0x71, 0x76, 0xac, 0xce,
+	0xde, 0x93, 0x1d, 0x3b, 0x06, 0x34, 0x0e, 0x54, 0x46, 0xff, 0x67, 0x05, 0x8a, 0x4d, 0x8f, 0xb2,
+	0x7f, 0xc8, 0x4f, 0x74, 0xf0, 0xec, 0x2f, 0x58, 0x37, 0x90, 0x9b, 0x83, 0x1c, 0xe1, 0x6d, 0x75,
+	0x1e, 0xe6, 0xa2, 0xd8, 0x25, 0x1e, 0xbf, 0x54, 0x60, 0x49, 0x24, 0x88, 0x94, 0x74, 0x8f, 0x29,
+	0x2c, 0x61, 0xbc, 0xa9, 0x58, 0xbc, 0x25, 0x38, 0x79, 0x38, 0x36, 0x19, 0xf6, 0xfb, 0x09, 0x38,
+	0x15, 0xe6, 0xc6, 0x31, 0x0f, 0xfc, 0xef, 0xc8, 0x87, 0x65, 0x28, 0x1d, 0x05, 0x41, 0x22, 0xf4,
+	0x61, 0x02, 0x4a, 0x65, 0x8f, 0x5a, 0x01, 0x8d, 0x6d, 0x32, 0x9e, 0x9c, 0xdc, 0xc0, 0xaf, 0xc2,
+	0xec, 0xd0, 0xf2, 0x02, 0xbb, 0x63, 0x0f, 0x2d, 0xf6, 0x47, 0x31, 0xbd, 0x92, 0x3c, 0x6a, 0x60,
+	0x42, 0x45, 0x3d, 0x03, 0xa7, 0xa7, 0x20, 0x22, 0xf1, 0xfa, 0x8b, 0x02, 0xb8, 0x15, 0x58, 0x5e,
+	0xf0, 0x19, 0x58, 0x55, 0xa6, 0x26, 0xd3, 0x12, 0x2c, 0x4c, 0xc4, 0x1f, 0xc7, 0x85, 0x06, 0x9f,
+	0x89, 0x15, 0xe7, 0xbe, 0xb8, 0xc4, 0xe3, 0x97, 0xb8, 0xfc, 0x5a, 0x81, 0xe5, 0xb2, 0x2b, 0x4e,
+	0x08, 0x9f, 0xc8, 0x2f, 0x4c, 0x7d, 0x1a, 0xce, 0x4c, 0x0d, 0x50, 0x02, 0xf0, 0x2b, 0x05, 0x4e,
+	0x12, 0x6a, 0x75, 0x9f, 0xcc, 0xe0, 0xaf, 0xc1, 0xa9, 0x23, 0xc1, 0xc9, 0x1d, 0xea, 0x45, 0xc8,
+	0xf6, 0x69, 0x60, 0x75, 0xad, 0xc0, 0x92, 0x21, 0x2d, 0x87, 0x76, 0xc7, 0xda, 0x35, 0xa9, 0x41,
+	0x22, 0x5d, 0xf5, 0x93, 0x04, 0x2c, 0xf0, 0xbd, 0xee, 0xe7, 0xff, 0xa0, 0xa6, 0xff, 0x17, 0xf8,
+	0x50, 0x81, 0xc5, 0x49, 0x80, 0xa2, 0xff, 0x04, 0xff, 0xe8, 0x83, 0x88, 0x29, 0x05, 0x21, 0x39,
+	0x6d, 0x0b, 0xfa, 0xb3, 0x04, 0x94, 0xe2, 0x43, 0xfa, 0xfc, 0xd0, 0x62, 0xf2, 0xd0, 0xe2, 0x53,
+	0x9f, 0x52, 0x7d, 0xa4, 0xc0, 0xe9, 0x29, 0x80, 0x7e, 0xba, 0x89, 0x8e, 0x1d, 0x5d, 0x24, 0x1e,
+	0x7a, 0x74, 0xf1, 0xa8, 0x53, 0xfd, 0x0b, 0x05, 0x16, 0x6b, 0xd4, 0xf7, 0xad, 0x1e, 0x15, 0xff,
+	0xe3, 0x8f, 0x6f, 0x35, 0xe3, 0xc7, 0xce, 0xa9, 0xf1, 0xdd, 0x0d, 0x3b, 0x9b, 0x38, 0x14, 0xda,
+	0x63, 0x9c, 0x4d, 0xfc, 0x49, 0x81, 0x79, 0x69, 0x45, 0xeb, 0xec, 0x3d, 0x39, 0xe8, 0xe0, 0x67,
+	0x20, 0x69, 0x77, 0xc3, 0x1d, 0xe4, 0xe4, 0x6d, 0x36, 0x13, 0xa8, 0x97, 0x01, 0xc7, 0xe3, 0x7e,
+	0x0c, 0xe8, 0x7e, 0x9e, 0x84, 0xf9, 0xd6, 0xd0, 0xb1, 0x03, 0x29, 0x7c, 0xb2, 0x0b, 0xff, 0x3f,
+	0xc1, 0xac, 0xcf, 0x82, 0x35, 0xc5, 0x5d, 0x00, 0x07, 0x36, 0x47, 0xf2, 0x9c, 0x57, 0xe6, 0x2c,
+	0xfc, 0x2c, 0xe4, 0x43, 0x95, 0xd1, 0x20, 0x90, 0x27, 0x9d, 0x20, 0x35, 0x46, 0x83, 0x00, 0x5f,
+	0x80, 0x53, 0x83, 0x51, 0x9f, 0xdf, 0x4d, 0x9b, 0x43, 0xea, 0x85, 0x37, 0xb7, 0x96, 0x17, 0xde,
+	0x21, 0x2f, 0x0c, 0x46, 0x7d, 0x76, 0x45, 0xdd, 0xa4, 0x9e, 0xb8, 0xb9, 0xb5, 0xbc, 0x00, 0x5f,
+	0x86, 0x9c, 0xe5, 0xf4, 0x5c, 0xcf, 0x0e, 0x76, 0xfb, 0xf2, 0xf2, 0x58, 0x0d, 0x2f, 0x6f, 0x0e,
+	0xc3, 0xbf, 0xae, 0x85, 0x9a, 0x64, 0xdc, 0x49, 0x7d, 0x19, 0x72, 0x11, 0x9f, 0x5d, 0x94, 0xea,
+	0xd7, 0xda, 0x5a, 0xd5, 0x6c, 0x35, 0xab, 0x15, 0xa3, 0x25, 0x2e, 0x7c, 0xb7, 0xdb, 0xd5, 0xaa,
+	0xd9, 0x2a, 0x6b, 0x75, 0xa4, 0xa8, 0x04, 0x80, 0x9b, 0xe4, 0xc6, 0xc7, 0x00, 0x29, 0x0f, 0x01,
+	0xe8, 0x0c, 0xe4, 0x3c, 0xf7, 0xae, 0x8c, 0x3d, 0xc1, 0xc3, 0xc9, 0x7a, 0xee, 0x5d, 0x1e, 0xb9,
+	0xaa, 0x01, 0x8e, 0x8f, 0x55, 0x66, 0x5b, 0xac, 0x78, 0x2b, 0x13, 0xc5, 0x7b, 0xec, 0x3f, 0x2a,
+	0xde, 0x62, 0x2b, 0xcf, 0xbe, 0xf3, 0x37, 0xa9, 0xe5, 0x04, 0xe1, 0x7a, 0xa5, 0xfe, 0x28, 0x01,
+	0x05, 0xc2, 0x38, 0x76, 0x9f, 0xb2, 0xfb, 0x2b, 0x9f, 0xcd, 0xd4, 0x2e, 0x57, 0x31, 0xc7, 0x65,
+	0x37, 0x47, 0xf2, 0x82, 0x27, 0x2e, 0x01, 0x36, 0x60, 0xc9, 0xa7, 0x1d, 0x77, 0xd0, 0xf5, 0xcd,
+	0x1d, 0xba, 0xcb, 0x1e, 0x6c, 0xf4, 0x2d, 0x3f, 0x90, 0x77, 0x91, 0x05, 0xb2, 0x20, 0x85, 0x9b,
+	0x5c, 0x56, 0xe3, 0x22, 0x7c, 0x0e, 0x16, 0x77, 0xec, 0x81, 0xe3, 0xf6, 0xd8, 0x55, 0xfb, 0x3e,
+	0xf5, 0x7c, 0x19, 0x2a, 0x4b, 0xaf, 0x34, 0xc1, 0x42, 0xd6, 0x14, 0x22, 0x31, 0xdd, 0xef, 0xc0,
+	0xda, 0x54, 0x2f, 0xe6, 0x2d, 0xdb, 0x09, 0xa8, 0x47, 0xbb, 0xa6, 0x47, 0x87, 0x8e, 0xdd, 0x11,
+	0xcf, 0x02, 0xc4, 0xde, 0xfd, 0x85, 0x29, 0xae, 0xb7, 0xa5, 0x3a, 0x19, 0x6b, 0x33, 0xb4, 0x3b,
+	0xc3, 0x91, 0x39, 0x62, 0x1f, 0x30, 0x5f, 0xc5, 0x14, 0x92, 0xed, 0x0c, 0x47, 0x6d, 0x46, 0xb3,
+	0x5b, 0xb1, 0xdb, 0x43, 0xb1, 0x78, 0x29, 0x84, 0x35, 0xd9, 0x11, 0x6c, 0x51, 0xeb, 0xf5, 0x3c,
+	0xda, 0xb3, 0x02, 0x09, 0xd3, 0x39, 0x58, 0x14, 0x90, 0xec, 0x9b, 0xf2, 0xbd, 0x91, 0x88, 0x47,
+	0x11, 0xf1, 0x48, 0x99, 0x78, 0x6d, 0x14, 0xa6, 0xef, 0xc9, 0xd1, 0x60, 0x6a, 0x9f, 0x04, 0xef,
+	0xb3, 0x38, 0x1a, 0x4c, 0xe9, 0xf5, 0xef, 0x70, 0x7a, 0x3a, 0x0a, 0x7d, 0x5b, 0xbc, 0x18, 0x29,
+	0x90, 0x93, 0x53, 0x82, 0xae, 0xd9, 0x83, 0x07, 0x74, 0xb5, 0xee, 0x95, 0x52, 0xf7, 0xef, 0x6a,
+	0xdd, 0x53, 0x7f, 0x13, 0x1d, 0xed, 0x87, 0xe9, 0x12, 0xad, 0xc6, 0x61, 0x5d, 0x50, 0x1e, 0x54,
+	0x17, 0x4a, 0x30, 0xe3, 0x53, 0xef, 0x8e, 0x3d, 0xe8, 0x85, 0xf7, 0xd3, 0x92, 0xc4, 0x2d, 0x78,
+	0x41, 0xc6, 0x4e, 0xef, 0x05, 0xd4, 0x1b, 0x58, 0x8e, 0xb3, 0x6f, 0x8a, 0x83, 0x8a, 0x41, 0x40,
+	0xbb, 0xe6, 0xf8, 0x75, 0x94, 0x58, 0x91, 0x9f, 0x13, 0xda, 0x7a, 0xa4, 0x4c, 0x22, 0x5d, 0x23,
+	0x54, 0xc5, 0xaf, 0x43, 0xd1, 0x93, 0x49, 0x6c, 0xfa, 0x6c, 0x7a, 0x64, 0x3d, 0x5a, 0x8c, 0x2e,
+	0x99, 0x63, 0x19, 0x4e, 0x0a, 0x5e, 0x9c, 0xc4, 0x6f, 0xc0, 0x9c, 0x15, 0xce, 0xad, 0xec, 0x3d,
+	0xb9, 0x6f, 0x99, 0x9c, 0x79, 0x52, 0xb4, 0x26, 0x68, 0x7c, 0x09, 0x66, 0x65, 0x44, 0x96, 0x63,
+	0x5b, 0xe3, 0x8d, 0xed, 0xa1, 0x27, 0x67, 0x1a, 0x13, 0x92, 0x7c, 0x30, 0x26, 0xd8, 0xff, 0xe8,
+	0x85, 0xf6, 0xb0, 0xcb, 0x2d, 0x1d, 0xe3, 0xdd, 0x45, 0xfc, 0x7d, 0x5a, 0x6a, 0xf2, 0x7d, 0xda,
+	0xe4, 0x7b, 0xb7, 0xf4, 0xa1, 0xf7, 0x6e, 0xea, 0x65, 0x58, 0x9c, 0x8c, 0x5f, 0x66, 0xd9, 0x2a,
+	0xa4, 0xf9, 0x5d, 0xfc, 0xa1, 0x65, 0x34, 0x76, 0xd9, 0x4e, 0x84, 0x82, 0xfa, 0x57, 0x05, 0x4e,
+	0xc6, 0x4d, 0x1c, 0xdf, 0x5d, 0xc8, 0x32, 0x64, 0x3b, 0xee, 0xc0, 0x1f, 0xf5, 0xa9, 0x17, 0xa2,
+	0x18, 0xd2, 0x13, 0x08, 0xa7, 0x0f, 0xbd, 0x00, 0x3c, 0x0d, 0xa7, 0x8e, 0x00, 0x20, 0xff, 0x8e,
+	0xff, 0x58, 0x81, 0x85, 0x29, 0xff, 0x3f, 0xa3, 0x3f, 0xb7, 0x4a, 0xec, 0xec, 0xec, 0x5f, 0x20,
+	0xcd, 0x72, 0x3f, 0x7c, 0xcb, 0x73, 0xea, 0xe8, 0xdf, 0x57, 0x96, 0xed, 0x94, 0x08, 0x2d, 0xb6,
+	0x4a, 0xf0, 0xaf, 0xad, 0xc3, 0x0f, 0xcf, 0xc2, 0xed, 0x73, 0x9e, 0xf1, 0xc4, 0x79, 0xda, 0xd1,
+	0xd3, 0xb8, 0xd4, 0x43, 0x4f, 0xe3, 0xd6, 0xbe, 0x91, 0x84, 0x5c, 0x6d, 0xbf, 0x75, 0xdb, 0xd9,
+	0x76, 0xac, 0x1e, 0x7f, 0x1d, 0x50, 0x6b, 0x1a, 0x37, 0xd1, 0x09, 0xf6, 0xce, 0xaa, 0xde, 0x30,
+	0xcc, 0x3a, 0x5b, 0x67, 0xb7, 0xab, 0xda, 0x15, 0xa4, 0xb0, 0x85, 0xb8, 0x49, 0x2a, 0xe6, 0x55,
+	0xfd, 0xa6, 0xe0, 0x24, 0xd8, 0x13, 0xa8, 0x76, 0xbd, 0x72, 0xad, 0xad, 0x8f, 0x99, 0x29, 0xbc,
+	0x04, 0xf3, 0xb5, 0x76, 0xd5, 0xa8, 0x34, 0xab, 0x31, 0x76, 0x96, 0x2d, 0xda, 0x9b, 0xd5, 0xc6,
+	0xa6, 0x20, 0x11, 0xb3, 0xdf, 0xae, 0xb7, 0x2a, 0x57, 0xea, 0xfa, 0x96, 0x60, 0xad, 0x30, 0xd6,
+	0x3b, 0x3a, 0x69, 0x6c, 0x57, 0x42, 0x97, 0x97, 0x31, 0x82, 0xfc, 0x66, 0xa5, 0xae, 0x11, 0x69,
+	0xe5, 0x40, 0xc1, 0x45, 0xc8, 0xe9, 0xf5, 0x76, 0x4d, 0xd2, 0x09, 0x5c, 0x82, 0x05, 0xad, 0x6d,
+	0x34, 0xcc, 0x4a, 0xbd, 0x4c, 0xf4, 0x9a, 0x5e, 0x37, 0xa4, 0x24, 0x85, 0x17, 0xa0, 0x68, 0x54,
+	0x6a, 0x7a, 0xcb, 0xd0, 0x6a, 0x4d, 0xc9, 0x64, 0xa3, 0xc8, 0xb6, 0xf4, 0x50, 0x07, 0xe1, 0x65,
+	0x58, 0xaa, 0x37, 0x4c, 0xf9, 0xa6, 0xcb, 0xbc, 0xae, 0x55, 0xdb, 0xba, 0x94, 0xad, 0xe0, 0x53,
+	0x80, 0x1b, 0x75, 0xb3, 0xdd, 0xdc, 0xd2, 0x0c, 0xdd, 0xac, 0x37, 0x6e, 0x48, 0xc1, 0x65, 0x5c,
+	0x84, 0xec, 0x78, 0x04, 0x07, 0x0c, 0x85, 0x42, 0x53, 0x23, 0xc6, 0x38, 0xd8, 0x83, 0x03, 0x06,
+	0x16, 0x5c, 0x21, 0x8d, 0x76, 0x73, 0xac, 0x36, 0x0f, 0x79, 0x09, 0x96, 0x64, 0xa5, 0x18, 0x6b,
+	0xb3, 0x52, 0x2f, 0x47, 0xe3, 0x3b, 0xc8, 0x2e, 0x27, 0x90, 0xb2, 0xb6, 0x07, 0x29, 0x3e, 0x1d,
+	0x59, 0x48, 0xd5, 0x1b, 0x75, 0xf6, 0xc4, 0x6d, 0x0e, 0xa0, 0xd2, 0xaa, 0xd4, 0x0d, 0xfd, 0x0a,
+	0xd1, 0xaa, 0x2c, 0x6c, 0xce, 0x08, 0x01, 0x64, 0xd1, 0xce, 0xc2, 0x4c, 0xa5, 0xb5, 0x5d, 0x6d,
+	0x68, 0x86, 0x0c, 0xb3, 0xd2, 0xba, 0xd6, 0x6e, 0xb0, 0xa7, 0x66, 0x07, 0x08, 0xe7, 0x21, 0x53,
+	0x69, 0x19, 0xfa, 0xdb, 0x06, 0x8b, 0x8b, 0xcb, 0x04, 0xaa, 0xe8, 0xe0, 0xf2, 0xda, 0xc7, 0x49,
+	0x48, 0xf1, 0x07, 0xb9, 0x05, 0xc8, 0xf1, 0xd9, 0x66, 0x6f, 0xe9, 0xd0, 0x09, 0x9c, 0x83, 0x54,
+	0xa5, 0x6e, 0x5c, 0x42, 0xff, 0x9d, 0xc0, 0x00, 0xe9, 0x36, 0x6f, 0xbf, 0x97, 0x61, 0xed, 0x4a,
+	0xdd, 0x78, 0xf5, 0x22, 0x7a, 0x3f, 0xc1, 0xcc, 0xb6, 0x05, 0xf1, 0x3f, 0xa1, 0x60, 0xe3, 0x02,
+	0xfa, 0x20, 0x12, 0x6c, 0x5c, 0x40, 0xff, 0x1b, 0x0a, 0xce, 0x6f, 0xa0, 0xff, 0x8b, 0x04, 0xe7,
+	0x37, 0xd0, 0xff, 0x87, 0x82, 0x8b, 0x17, 0xd0, 0x17, 0x22, 0xc1, 0xc5, 0x0b, 0xe8, 0x8b, 0x19,
+	0x16, 0x0b, 0x8f, 0xe4, 0xfc, 0x06, 0xfa, 0x52, 0x36, 0xa2, 0x2e, 0x5e, 0x40, 0x5f, 0xce, 0xb2,
+	0xf9, 0x8f, 0x66, 0x15, 0x7d, 0x05, 0xb1, 0x61, 0xb2, 0x09, 0x42, 0x5f, 0xe5, 0x4d, 0x26, 0x42,
+	0x5f, 0x43, 0x2c, 0x46, 0xc6, 0xe5, 0xe4, 0x87, 0x5c, 0x72, 0x53, 0xd7, 0x08, 0xfa, 0x7a, 0x46,
+	0x3c, 0xe1, 0x2b, 0x57, 0x6a, 0x5a, 0x15, 0x61, 0xde, 0x83, 0xa1, 0xf2, 0xcd, 0x73, 0xac, 0xc9,
+	0xd2, 0x13, 0x7d, 0xab, 0xc9, 0x1c, 0x5e, 0xd7, 0x48, 0xf9, 0x4d, 0x8d, 0xa0, 0x6f, 0x9f, 0x63,
+	0x0e, 0xaf, 0x6b, 0x44, 0xe2, 0xf5, 0x9d, 0x26, 0x53, 0xe4, 0xa2, 0x8f, 0xce, 0xb1, 0x41, 0x4b,
+	0xfe, 0x77, 0x9b, 0x38, 0x0b, 0xc9, 0xcd, 0x8a, 0x81, 0x3e, 0xe6, 0xde, 0x58, 0x8a, 0xa2, 0xef,
+	0x21, 0xc6, 0x6c, 0xe9, 0x06, 0xfa, 0x3e, 0x63, 0xa6, 0x8d, 0x76, 0xb3, 0xaa, 0xa3, 0xa7, 0xd8,
+	0xe0, 0xae, 0xe8, 0x8d, 0x9a, 0x6e, 0x90, 0x9b, 0xe8, 0x07, 0x5c, 0xfd, 0xad, 0x56, 0xa3, 0x8e,
+	0x3e, 0x41, 0xb8, 0x08, 0xa0, 0xbf, 0xdd, 0x24, 0x7a, 0xab, 0x55, 0x69, 0xd4, 0xd1, 0xb3, 0x6b,
+	0xdb, 0x80, 0x0e, 0x97, 0x03, 0x16, 0x40, 0xbb, 0x7e, 0xb5, 0xde, 0xb8, 0x51, 0x47, 0x27, 0x18,
+	0xd1, 0x24, 0x7a, 0x53, 0x23, 0x3a, 0x52, 0x30, 0x40, 0x46, 0x3c, 0x30, 0x44, 0x09, 0x3c, 0x0b,
+	0x59, 0xd2, 0xa8, 0x56, 0x37, 0xb5, 0xf2, 0x55, 0x94, 0xdc, 0x9c, 0x87, 0x39, 0xdb, 0x5d, 0xbf,
+	0x63, 0x07, 0xd4, 0xf7, 0xc5, 0x93, 0xef, 0x9d, 0x0c, 0xff, 0x39, 0xff, 0xb7, 0x01, 0x00, 0xcd,
+	0xf3, 0xd8, 0x27, 0x2c, 0x2e, 0x00, 0x00,
 }
@@ -92,6 +92,8 @@ type QueryClient interface {
 	StreamHealth(ctx context.Context, in *query.StreamHealthRequest, opts ...grpc.CallOption) (Query_StreamHealthClient, error)
 	// UpdateStream asks the server to return a stream of the updates that have been applied to its database.
 	UpdateStream(ctx context.Context, in *query.UpdateStreamRequest, opts ...grpc.CallOption) (Query_UpdateStreamClient, error)
+	// UpdateStreamAck reports the position an UpdateStream consumer has applied.
+	UpdateStreamAck(ctx context.Context, in *query.UpdateStreamAckRequest, opts ...grpc.CallOption) (*query.UpdateStreamAckResponse, error)
 }
 
 type queryClient struct {
@@ -383,6 +385,15 @@ func (x *queryUpdateStreamClient) Recv() (*query.UpdateStreamResponse, error) {
 	return m, nil
 }
 
+func (c *queryClient) UpdateStreamAck(ctx context.Context, in *query.UpdateStreamAckRequest, opts ...grpc.CallOption) (*query.UpdateStreamAckResponse, error) {
+	out := new(query.UpdateStreamAckResponse)
+	err := grpc.Invoke(ctx, "/queryservice.Query/UpdateStreamAck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Query service
 
 type QueryServer interface {
@@ -435,6 +446,8 @@ type QueryServer interface {
 	StreamHealth(*query.StreamHealthRequest, Query_StreamHealthServer) error
 	// UpdateStream asks the server to return a stream of the updates that have been applied to its database.
 	UpdateStream(*query.UpdateStreamRequest, Query_UpdateStreamServer) error
+	// UpdateStreamAck reports the position an UpdateStream consumer has applied.
+	UpdateStreamAck(context.Context, *query.UpdateStreamAckRequest) (*query.UpdateStreamAckResponse, error)
 }
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
@@ -831,6 +844,24 @@ func (x *queryUpdateStreamServer) Send(m *query.UpdateStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_UpdateStreamAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.UpdateStreamAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpdateStreamAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/UpdateStreamAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpdateStreamAck(ctx, req.(*query.UpdateStreamAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queryservice.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -903,6 +934,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitQuery",
 			Handler:    _Query_SplitQuery_Handler,
 		},
+		{
+			MethodName: "UpdateStreamAck",
+			Handler:    _Query_UpdateStreamAck_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -932,36 +967,37 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdb, 0x6e, 0xd4, 0x40,
-	0x0c, 0x86, 0xe1, 0xa2, 0x2d, 0x72, 0xc3, 0x69, 0x4a, 0x81, 0xa6, 0xa5, 0x2d, 0x7d, 0x80, 0x0a,
-	0x01, 0x12, 0x52, 0x25, 0x2e, 0xda, 0x08, 0x04, 0xaa, 0x38, 0x65, 0x59, 0x89, 0x2b, 0xa4, 0xd9,
-	0xc4, 0x5a, 0xa2, 0xcd, 0x26, 0xd9, 0xc9, 0x04, 0xc1, 0x13, 0xf1, 0x9a, 0x88, 0x4c, 0xec, 0xcc,
-	0x4c, 0x12, 0x2e, 0xfd, 0xff, 0xf6, 0x27, 0x67, 0xbc, 0xf6, 0x82, 0xd8, 0x34, 0xa8, 0x7e, 0xd7,
-	0xa8, 0x7e, 0x66, 0x09, 0x9e, 0x57, 0xaa, 0xd4, 0xa5, 0x08, 0x6c, 0x2d, 0xdc, 0x6d, 0x23, 0x63,
-	0x3d, 0xff, 0x13, 0xc0, 0xd6, 0x97, 0x7f, 0xb1, 0xb8, 0x80, 0x9d, 0x37, 0xbf, 0x30, 0x69, 0x34,
-	0x8a, 0xfd, 0x73, 0x93, 0xd2, 0xc5, 0x31, 0x6e, 0x1a, 0xac, 0x75, 0xf8, 0xd0, 0x97, 0xeb, 0xaa,
-	0x2c, 0x6a, 0x3c, 0xbb, 0x21, 0xde, 0x43, 0xd0, 0x89, 0x57, 0x52, 0x27, 0x3f, 0x44, 0xe8, 0x66,
-	0xb6, 0x22, 0x51, 0x0e, 0x47, 0x3d, 0x46, 0x7d, 0x84, 0xdb, 0x33, 0xad, 0x50, 0xae, 0xa9, 0x19,
-	0xca, 0x77, 0x54, 0x82, 0x1d, 0x8d, 0x9b, 0x44, 0x7b, 0x76, 0x53, 0xbc, 0x84, 0xad, 0x2b, 0x5c,
-	0x66, 0x85, 0xd8, 0xeb, 0x52, 0xdb, 0x88, 0xea, 0x1f, 0xb8, 0x22, 0x77, 0xf1, 0x0a, 0xb6, 0xa3,
-	0x72, 0xbd, 0xce, 0xb4, 0xa0, 0x0c, 0x13, 0x52, 0xdd, 0xbe, 0xa7, 0x72, 0xe1, 0x6b, 0xb8, 0x15,
-	0x97, 0x79, 0xbe, 0x90, 0xc9, 0x4a, 0xd0, 0x7b, 0x91, 0x40, 0xc5, 0x8f, 0x06, 0x3a, 0x97, 0x5f,
-	0xc0, 0xce, 0x67, 0x85, 0x95, 0x54, 0xfd, 0x10, 0xba, 0xd8, 0x1f, 0x02, 0xcb, 0x5c, 0xfb, 0x09,
-	0xee, 0x98, 0x76, 0x3a, 0x2b, 0x15, 0x47, 0x4e, 0x97, 0x24, 0x13, 0xe9, 0xc9, 0x84, 0xcb, 0xc0,
-	0x39, 0xdc, 0xa3, 0x16, 0x19, 0x79, 0xec, 0xf5, 0xee, 0x43, 0x4f, 0x26, 0x7d, 0xc6, 0x7e, 0x83,
-	0xfb, 0x91, 0x42, 0xa9, 0xf1, 0xab, 0x92, 0x45, 0x2d, 0x13, 0x9d, 0x95, 0x85, 0xa0, 0xba, 0x81,
-	0x43, 0xe0, 0xd3, 0xe9, 0x04, 0x26, 0xbf, 0x85, 0xdd, 0x99, 0x96, 0x4a, 0x77, 0xa3, 0x3b, 0xe0,
-	0x1f, 0x07, 0x6b, 0x44, 0x0b, 0xc7, 0x2c, 0x87, 0x83, 0x9a, 0xe7, 0xc8, 0x9c, 0x5e, 0x1b, 0x70,
-	0x6c, 0x8b, 0x39, 0xdf, 0x61, 0x2f, 0x2a, 0x8b, 0x24, 0x6f, 0x52, 0xe7, 0x5b, 0x9f, 0xf2, 0xc3,
-	0x0f, 0x3c, 0xe2, 0x9e, 0xfd, 0x2f, 0x85, 0xf9, 0x31, 0xdc, 0x8d, 0x51, 0xa6, 0x36, 0x9b, 0x86,
-	0xea, 0xe9, 0xc4, 0x3d, 0x9e, 0xb2, 0xed, 0x55, 0x6e, 0x97, 0x81, 0xd6, 0x2f, 0xb4, 0x37, 0xc4,
-	0xdb, 0xbe, 0xc3, 0x51, 0xcf, 0x1e, 0xb4, 0xed, 0x98, 0xd3, 0x70, 0x32, 0x52, 0xe3, 0xdc, 0x87,
-	0xd3, 0xe9, 0x04, 0xfb, 0x48, 0x7c, 0xc0, 0xba, 0x96, 0x4b, 0x34, 0x8b, 0xcf, 0x47, 0xc2, 0x51,
-	0xfd, 0x23, 0xe1, 0x99, 0xd6, 0x91, 0x88, 0x00, 0x3a, 0xf3, 0x32, 0x59, 0x89, 0xc7, 0x6e, 0xfe,
-	0x65, 0x3f, 0xee, 0x83, 0x11, 0x87, 0x9b, 0x8a, 0x00, 0x66, 0x55, 0x9e, 0x69, 0x73, 0x4e, 0x09,
-	0xd2, 0x4b, 0x3e, 0xc4, 0x76, 0x18, 0x72, 0x0d, 0x81, 0xe9, 0xef, 0x1d, 0xca, 0x5c, 0xf7, 0x97,
-	0xd4, 0x16, 0xfd, 0xe7, 0x77, 0x3d, 0xeb, 0xb3, 0xae, 0x21, 0x98, 0x57, 0xa9, 0xd4, 0xf4, 0x4a,
-	0x04, 0xb3, 0x45, 0x1f, 0xe6, 0x7a, 0x3d, 0x6c, 0xb1, 0xdd, 0xfe, 0x61, 0xbc, 0xf8, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0xc5, 0x90, 0xa5, 0x56, 0x61, 0x06, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdf, 0x4f, 0xd4, 0x40,
+	0x10, 0xc7, 0xf5, 0x01, 0x30, 0x43, 0x15, 0x5d, 0x44, 0xa5, 0xc0, 0x81, 0xfc, 0x01, 0xc4, 0xa8,
+	0x89, 0x09, 0x89, 0x0f, 0xd0, 0x68, 0x34, 0xc4, 0x5f, 0x3d, 0x49, 0x7c, 0x32, 0x59, 0x7a, 0x13,
+	0x6c, 0xe8, 0xb5, 0x65, 0xbb, 0x67, 0xf4, 0x6f, 0xf7, 0xc5, 0xd0, 0xed, 0x4c, 0x67, 0xf7, 0x5a,
+	0x1e, 0xf7, 0xfb, 0x9d, 0xf9, 0x64, 0x3a, 0x73, 0x33, 0x07, 0xea, 0x7a, 0x81, 0xe6, 0x6f, 0x83,
+	0xe6, 0x77, 0x9e, 0xe1, 0x51, 0x6d, 0x2a, 0x5b, 0xa9, 0x48, 0x6a, 0xf1, 0x7a, 0xfb, 0x72, 0xd6,
+	0xcb, 0x7f, 0x11, 0xac, 0x7c, 0xbb, 0x79, 0xab, 0x63, 0x58, 0x7b, 0xf7, 0x07, 0xb3, 0x85, 0x45,
+	0xb5, 0x75, 0xe4, 0x42, 0xba, 0x77, 0x8a, 0xd7, 0x0b, 0x6c, 0x6c, 0xfc, 0x24, 0x94, 0x9b, 0xba,
+	0x2a, 0x1b, 0x3c, 0xbc, 0xa3, 0x3e, 0x42, 0xd4, 0x89, 0xa7, 0xda, 0x66, 0xbf, 0x54, 0xec, 0x47,
+	0xb6, 0x22, 0x51, 0x76, 0x06, 0x3d, 0x46, 0x7d, 0x86, 0xfb, 0x53, 0x6b, 0x50, 0xcf, 0xa9, 0x18,
+	0x8a, 0xf7, 0x54, 0x82, 0xed, 0x0e, 0x9b, 0x44, 0x7b, 0x71, 0x57, 0xbd, 0x86, 0x95, 0x53, 0xbc,
+	0xcc, 0x4b, 0xb5, 0xd9, 0x85, 0xb6, 0x2f, 0xca, 0x7f, 0xec, 0x8b, 0x5c, 0xc5, 0x1b, 0x58, 0x4d,
+	0xaa, 0xf9, 0x3c, 0xb7, 0x8a, 0x22, 0xdc, 0x93, 0xf2, 0xb6, 0x02, 0x95, 0x13, 0xdf, 0xc2, 0xbd,
+	0xb4, 0x2a, 0x8a, 0x0b, 0x9d, 0x5d, 0x29, 0xea, 0x17, 0x09, 0x94, 0xfc, 0x74, 0x49, 0xe7, 0xf4,
+	0x63, 0x58, 0xfb, 0x6a, 0xb0, 0xd6, 0xa6, 0x1f, 0x42, 0xf7, 0x0e, 0x87, 0xc0, 0x32, 0xe7, 0x7e,
+	0x81, 0x07, 0xae, 0x9c, 0xce, 0x9a, 0xa9, 0x5d, 0xaf, 0x4a, 0x92, 0x89, 0xb4, 0x37, 0xe2, 0x32,
+	0xf0, 0x1c, 0x1e, 0x52, 0x89, 0x8c, 0x9c, 0x04, 0xb5, 0x87, 0xd0, 0xfd, 0x51, 0x9f, 0xb1, 0x3f,
+	0xe0, 0x51, 0x62, 0x50, 0x5b, 0xfc, 0x6e, 0x74, 0xd9, 0xe8, 0xcc, 0xe6, 0x55, 0xa9, 0x28, 0x6f,
+	0xc9, 0x21, 0xf0, 0xc1, 0x78, 0x00, 0x93, 0xdf, 0xc3, 0xfa, 0xd4, 0x6a, 0x63, 0xbb, 0xd1, 0x6d,
+	0xf3, 0x8f, 0x83, 0x35, 0xa2, 0xc5, 0x43, 0x96, 0xc7, 0x41, 0xcb, 0x73, 0x64, 0x4e, 0xaf, 0x2d,
+	0x71, 0xa4, 0xc5, 0x9c, 0x9f, 0xb0, 0x99, 0x54, 0x65, 0x56, 0x2c, 0x66, 0xde, 0xb7, 0x3e, 0xe7,
+	0xc6, 0x2f, 0x79, 0xc4, 0x3d, 0xbc, 0x2d, 0x84, 0xf9, 0x29, 0x6c, 0xa4, 0xa8, 0x67, 0x92, 0x4d,
+	0x43, 0x0d, 0x74, 0xe2, 0x4e, 0xc6, 0x6c, 0xb9, 0xca, 0xed, 0x32, 0xd0, 0xfa, 0xc5, 0x72, 0x43,
+	0x82, 0xed, 0xdb, 0x19, 0xf4, 0xe4, 0xa0, 0xa5, 0xe3, 0x4e, 0xc3, 0xfe, 0x40, 0x8e, 0x77, 0x1f,
+	0x0e, 0xc6, 0x03, 0xe4, 0x91, 0xf8, 0x84, 0x4d, 0xa3, 0x2f, 0xd1, 0x2d, 0x3e, 0x1f, 0x09, 0x4f,
+	0x0d, 0x8f, 0x44, 0x60, 0x8a, 0x23, 0x91, 0x00, 0x74, 0xe6, 0x49, 0x76, 0xa5, 0x9e, 0xf9, 0xf1,
+	0x27, 0xfd, 0xb8, 0xb7, 0x07, 0x1c, 0x2e, 0x2a, 0x01, 0x98, 0xd6, 0x45, 0x6e, 0xdd, 0x39, 0x25,
+	0x48, 0x2f, 0x85, 0x10, 0xe9, 0x30, 0xe4, 0x0c, 0x22, 0x57, 0xdf, 0x07, 0xd4, 0x85, 0xed, 0x2f,
+	0xa9, 0x14, 0xc3, 0xf6, 0xfb, 0x9e, 0xf8, 0xac, 0x33, 0x88, 0xce, 0xeb, 0x99, 0xb6, 0xd4, 0x25,
+	0x82, 0x49, 0x31, 0x84, 0xf9, 0x9e, 0x80, 0xa5, 0xb0, 0x21, 0xbd, 0x9b, 0x46, 0xed, 0x0d, 0xe4,
+	0x88, 0x6e, 0x4d, 0xc6, 0x6c, 0xa2, 0x5e, 0xac, 0xb6, 0x7f, 0x42, 0xaf, 0xfe, 0x0f, 0x00, 0xcf,
+	0x33, 0xa0, 0x60, 0xb5, 0x06, 0x00, 0x00,
 }
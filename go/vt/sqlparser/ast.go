@@ -576,6 +576,7 @@ type DDL struct {
 	NewName       TableName
 	IfExists      bool
 	TableSpec     *TableSpec
+	OptLike       *OptLike
 	PartitionSpec *PartitionSpec
 }
 
@@ -592,7 +593,9 @@ const (
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
-		if node.TableSpec == nil {
+		if node.OptLike != nil {
+			buf.Myprintf("%s table %v %v", node.Action, node.NewName, node.OptLike)
+		} else if node.TableSpec == nil {
 			buf.Myprintf("%s table %v", node.Action, node.NewName)
 		} else {
 			buf.Myprintf("%s table %v %v", node.Action, node.NewName, node.TableSpec)
@@ -608,6 +611,9 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 	case AlterStr:
 		if node.PartitionSpec != nil {
 			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.PartitionSpec)
+		} else if node.Table.IsEmpty() {
+			// Only ALTER TABLESPACE produces an alter without a table.
+			buf.Myprintf("%s tablespace", node.Action)
 		} else {
 			buf.Myprintf("%s table %v", node.Action, node.Table)
 		}
@@ -625,9 +631,28 @@ func (node *DDL) WalkSubtree(visit Visit) error {
 		visit,
 		node.Table,
 		node.NewName,
+		node.OptLike,
 	)
 }
 
+// OptLike is the LIKE clause of CREATE TABLE new LIKE old.
+type OptLike struct {
+	LikeTable TableName
+}
+
+// Format formats the node.
+func (node *OptLike) Format(buf *TrackedBuffer) {
+	buf.Myprintf("like %v", node.LikeTable)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *OptLike) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.LikeTable)
+}
+
 // Partition strings
 const (
 	ReorganizeStr = "reorganize partition"
@@ -36,6 +36,32 @@ func Normalize(stmt Statement, bindVars map[string]*querypb.BindVariable, prefix
 	_ = Walk(nz.WalkStatement, stmt)
 }
 
+// NormalizeINLists changes IN and NOT IN clauses whose right hand side
+// is a list of values to use a list bind var, and adds the values to
+// bindVars. All other values are left untouched, which makes the result
+// independent of the number of values in each list. INSERT statements
+// are not changed. It returns false if the statement was not modified.
+func NormalizeINLists(stmt Statement, bindVars map[string]*querypb.BindVariable, prefix string) bool {
+	if _, ok := stmt.(*Insert); ok {
+		return false
+	}
+	nz := newNormalizer(stmt, bindVars, prefix)
+	changed := false
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if node, ok := node.(*ComparisonExpr); ok {
+			if _, ok := node.Right.(ValTuple); !ok {
+				return true, nil
+			}
+			nz.convertComparison(node)
+			if _, ok := node.Right.(ListArg); ok {
+				changed = true
+			}
+		}
+		return true, nil
+	}, stmt)
+	return changed
+}
+
 type normalizer struct {
 	stmt     Statement
 	bindVars map[string]*querypb.BindVariable
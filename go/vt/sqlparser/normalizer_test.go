@@ -187,6 +187,70 @@ func TestNormalize(t *testing.T) {
 	}
 }
 
+func TestNormalizeINLists(t *testing.T) {
+	prefix := "bv"
+	testcases := []struct {
+		in      string
+		outstmt string
+		outbv   map[string]*querypb.BindVariable
+	}{{
+		// IN clause with vals
+		in:      "select * from t where v1 = 1 and v2 in (1, '2')",
+		outstmt: "select * from t where v1 = 1 and v2 in ::bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{1, []byte("2")}),
+		},
+	}, {
+		// NOT IN clause in DML
+		in:      "delete from t where v1 not in (1, 2, 3)",
+		outstmt: "delete from t where v1 not in ::bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+		},
+	}, {
+		// bv collision
+		in:      "update t set v2 = :bv1 where v1 in (1, 2)",
+		outstmt: "update t set v2 = :bv1 where v1 in ::bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv2": sqltypes.TestBindVariable([]interface{}{1, 2}),
+		},
+	}, {
+		// IN clause with non-val values
+		in:      "select * from t where v1 in (1, a)",
+		outstmt: "select * from t where v1 in (1, a)",
+		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// IN clause with subquery
+		in:      "select * from t where v1 in (select v1 from t2)",
+		outstmt: "select * from t where v1 in (select v1 from t2)",
+		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// IN clause within an upsert
+		in:      "insert into t(v1) select v1 from t2 where v1 in (1, 2) on duplicate key update v1 = 1",
+		outstmt: "insert into t(v1) select v1 from t2 where v1 in (1, 2) on duplicate key update v1 = 1",
+		outbv:   map[string]*querypb.BindVariable{},
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		bv := make(map[string]*querypb.BindVariable)
+		changed := NormalizeINLists(stmt, bv, prefix)
+		outstmt := String(stmt)
+		if outstmt != tc.outstmt {
+			t.Errorf("Query:\n%s:\n%s, want\n%s", tc.in, outstmt, tc.outstmt)
+		}
+		if !reflect.DeepEqual(tc.outbv, bv) {
+			t.Errorf("Query:\n%s:\n%v, want\n%v", tc.in, bv, tc.outbv)
+		}
+		if want := len(tc.outbv) != 0; changed != want {
+			t.Errorf("Query:\n%s: changed: %v, want %v", tc.in, changed, want)
+		}
+	}
+}
+
 func TestGetBindVars(t *testing.T) {
 	stmt, err := Parse("select * from t where :v1 = :v2 and :v2 = :v3 and :v4 in ::v5")
 	if err != nil {
@@ -732,6 +732,9 @@ var (
 	}, {
 		input:  "alter table a rename key foo to bar",
 		output: "alter table a",
+	}, {
+		input:  "alter table a add constraint fk foreign key (b) references c (d) without validation",
+		output: "alter table a",
 	}, {
 		input:  "alter table e auto_increment = 20",
 		output: "alter table e",
@@ -744,12 +747,45 @@ var (
 	}, {
 		input:  "alter table e comment = 'hello'",
 		output: "alter table e",
+	}, {
+		input:  "alter table e encryption = 'Y'",
+		output: "alter table e",
+	}, {
+		input:  "alter table e compression = 'zlib'",
+		output: "alter table e",
+	}, {
+		input:  "alter table e tablespace ts",
+		output: "alter table e",
+	}, {
+		input:  "alter tablespace ts encryption = 'Y'",
+		output: "alter tablespace",
+	}, {
+		input:  "select tablespace from tablespace",
+		output: "select `tablespace` from `tablespace`",
 	}, {
 		input:  "alter table a reorganize partition b into (partition c values less than (?), partition d values less than (maxvalue))",
 		output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
 	}, {
 		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
 		output: "alter table a",
+	}, {
+		input:  "alter table a add partition (partition p2 values less than (20))",
+		output: "alter table a",
+	}, {
+		input:  "alter table a drop partition p0, p1",
+		output: "alter table a",
+	}, {
+		input:  "alter table a truncate partition p0",
+		output: "alter table a",
+	}, {
+		input:  "alter table `b`.`a` truncate partition all",
+		output: "alter table b.a",
+	}, {
+		input:  "alter table a coalesce partition 2",
+		output: "alter table a",
+	}, {
+		input:  "alter table `b`.`a` rename to `b`.`c`",
+		output: "rename table b.a b.c",
 	}, {
 		input: "create table a",
 	}, {
@@ -760,6 +796,14 @@ var (
 	}, {
 		input:  "create table if not exists a (\n\t`a` int\n)",
 		output: "create table a (\n\ta int\n)",
+	}, {
+		input: "create table a like b",
+	}, {
+		input:  "create table if not exists a like b",
+		output: "create table a like b",
+	}, {
+		input:  "create table `a` (like `b`.`c`)",
+		output: "create table a like b.c",
 	}, {
 		input:  "create table a ignore me this is garbage",
 		output: "create table a",
@@ -0,0 +1,32 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import querypb "github.com/youtube/vitess/go/vt/proto/query"
+
+// RedactSQLQuery returns the query with all literal values replaced
+// by bind variables, so that it can be logged without leaking data.
+// Table and column names are preserved.
+func RedactSQLQuery(sql string) (string, error) {
+	query, comments := SplitTrailingComments(sql)
+	stmt, err := Parse(query)
+	if err != nil {
+		return "", err
+	}
+	Normalize(stmt, make(map[string]*querypb.BindVariable), "redacted")
+	return String(stmt) + comments, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import "testing"
+
+func TestRedactSQLQuery(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "select a, b from t where id = 1 and name = 'secret' /* trailing */",
+		out: "select a, b from t where id = :redacted1 and name = :redacted2 /* trailing */",
+	}, {
+		in:  "insert into t(id, name) values (1, 'secret')",
+		out: "insert into t(id, name) values (:redacted1, :redacted2)",
+	}, {
+		in:  "update t set name = 'secret' where id in (1, 2)",
+		out: "update t set name = :redacted1 where id in ::redacted2",
+	}, {
+		in:  "delete from t where id = :id",
+		out: "delete from t where id = :id",
+	}}
+	for _, tcase := range testcases {
+		got, err := RedactSQLQuery(tcase.in)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got != tcase.out {
+			t.Errorf("RedactSQLQuery(%s):\n%s, want\n%s", tcase.in, got, tcase.out)
+		}
+	}
+
+	if _, err := RedactSQLQuery("not a query"); err == nil {
+		t.Errorf("RedactSQLQuery: nil error, want syntax error")
+	}
+}
@@ -4,6 +4,7 @@ package sqlparser
 import __yyfmt__ "fmt"
 
 //line sql.y:18
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -90,6 +91,7 @@ type yySymType struct {
 	partDefs          []*PartitionDefinition
 	partDef           *PartitionDefinition
 	partSpec          *PartitionSpec
+	optLike           *OptLike
 }
 
 const LEX_ERROR = 57346
@@ -210,81 +212,82 @@ const LESS = 57460
 const THAN = 57461
 const PROCEDURE = 57462
 const TRIGGER = 57463
-const VINDEX = 57464
-const VINDEXES = 57465
-const STATUS = 57466
-const VARIABLES = 57467
-const BIT = 57468
-const TINYINT = 57469
-const SMALLINT = 57470
-const MEDIUMINT = 57471
-const INT = 57472
-const INTEGER = 57473
-const BIGINT = 57474
-const INTNUM = 57475
-const REAL = 57476
-const DOUBLE = 57477
-const FLOAT_TYPE = 57478
-const DECIMAL = 57479
-const NUMERIC = 57480
-const TIME = 57481
-const TIMESTAMP = 57482
-const DATETIME = 57483
-const YEAR = 57484
-const CHAR = 57485
-const VARCHAR = 57486
-const BOOL = 57487
-const CHARACTER = 57488
-const VARBINARY = 57489
-const NCHAR = 57490
-const TEXT = 57491
-const TINYTEXT = 57492
-const MEDIUMTEXT = 57493
-const LONGTEXT = 57494
-const BLOB = 57495
-const TINYBLOB = 57496
-const MEDIUMBLOB = 57497
-const LONGBLOB = 57498
-const JSON = 57499
-const ENUM = 57500
-const NULLX = 57501
-const AUTO_INCREMENT = 57502
-const APPROXNUM = 57503
-const SIGNED = 57504
-const UNSIGNED = 57505
-const ZEROFILL = 57506
-const DATABASES = 57507
-const TABLES = 57508
-const VITESS_KEYSPACES = 57509
-const VITESS_SHARDS = 57510
-const VITESS_TABLETS = 57511
-const VSCHEMA_TABLES = 57512
-const NAMES = 57513
-const CHARSET = 57514
-const GLOBAL = 57515
-const SESSION = 57516
-const CURRENT_TIMESTAMP = 57517
-const DATABASE = 57518
-const CURRENT_DATE = 57519
-const CURRENT_TIME = 57520
-const LOCALTIME = 57521
-const LOCALTIMESTAMP = 57522
-const UTC_DATE = 57523
-const UTC_TIME = 57524
-const UTC_TIMESTAMP = 57525
-const REPLACE = 57526
-const CONVERT = 57527
-const CAST = 57528
-const GROUP_CONCAT = 57529
-const SEPARATOR = 57530
-const MATCH = 57531
-const AGAINST = 57532
-const BOOLEAN = 57533
-const LANGUAGE = 57534
-const WITH = 57535
-const QUERY = 57536
-const EXPANSION = 57537
-const UNUSED = 57538
+const TABLESPACE = 57464
+const VINDEX = 57465
+const VINDEXES = 57466
+const STATUS = 57467
+const VARIABLES = 57468
+const BIT = 57469
+const TINYINT = 57470
+const SMALLINT = 57471
+const MEDIUMINT = 57472
+const INT = 57473
+const INTEGER = 57474
+const BIGINT = 57475
+const INTNUM = 57476
+const REAL = 57477
+const DOUBLE = 57478
+const FLOAT_TYPE = 57479
+const DECIMAL = 57480
+const NUMERIC = 57481
+const TIME = 57482
+const TIMESTAMP = 57483
+const DATETIME = 57484
+const YEAR = 57485
+const CHAR = 57486
+const VARCHAR = 57487
+const BOOL = 57488
+const CHARACTER = 57489
+const VARBINARY = 57490
+const NCHAR = 57491
+const TEXT = 57492
+const TINYTEXT = 57493
+const MEDIUMTEXT = 57494
+const LONGTEXT = 57495
+const BLOB = 57496
+const TINYBLOB = 57497
+const MEDIUMBLOB = 57498
+const LONGBLOB = 57499
+const JSON = 57500
+const ENUM = 57501
+const NULLX = 57502
+const AUTO_INCREMENT = 57503
+const APPROXNUM = 57504
+const SIGNED = 57505
+const UNSIGNED = 57506
+const ZEROFILL = 57507
+const DATABASES = 57508
+const TABLES = 57509
+const VITESS_KEYSPACES = 57510
+const VITESS_SHARDS = 57511
+const VITESS_TABLETS = 57512
+const VSCHEMA_TABLES = 57513
+const NAMES = 57514
+const CHARSET = 57515
+const GLOBAL = 57516
+const SESSION = 57517
+const CURRENT_TIMESTAMP = 57518
+const DATABASE = 57519
+const CURRENT_DATE = 57520
+const CURRENT_TIME = 57521
+const LOCALTIME = 57522
+const LOCALTIMESTAMP = 57523
+const UTC_DATE = 57524
+const UTC_TIME = 57525
+const UTC_TIMESTAMP = 57526
+const REPLACE = 57527
+const CONVERT = 57528
+const CAST = 57529
+const GROUP_CONCAT = 57530
+const SEPARATOR = 57531
+const MATCH = 57532
+const AGAINST = 57533
+const BOOLEAN = 57534
+const LANGUAGE = 57535
+const WITH = 57536
+const QUERY = 57537
+const EXPANSION = 57538
+const UNUSED = 57539
 
 var yyToknames = [...]string{
 	"$end",
@@ -425,6 +428,7 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"TABLESPACE",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
@@ -502,6 +506,7 @@ var yyToknames = [...]string{
 	"UNUSED",
 	"';'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -516,931 +521,922 @@ var yyExca = [...]int{
 	-1, 3,
 	5, 22,
 	-2, 4,
-	-1, 202,
-	79, 631,
-	108, 631,
+	-1, 207,
+	79, 637,
+	108, 637,
 	-2, 47,
-	-1, 203,
-	79, 605,
-	108, 605,
+	-1, 208,
+	79, 611,
+	108, 611,
 	-2, 48,
-	-1, 204,
-	79, 595,
-	108, 595,
+	-1, 209,
+	79, 601,
+	108, 601,
 	-2, 42,
-	-1, 206,
-	79, 619,
-	108, 619,
-	-2, 44,
-	-1, 210,
-	108, 496,
-	-2, 492,
 	-1, 211,
-	108, 497,
-	-2, 493,
-	-1, 638,
-	108, 499,
-	-2, 495,
-	-1, 779,
+	79, 625,
+	108, 625,
+	-2, 44,
+	-1, 215,
+	108, 502,
+	-2, 498,
+	-1, 216,
+	108, 503,
+	-2, 499,
+	-1, 648,
+	108, 505,
+	-2, 501,
+	-1, 792,
 	5, 22,
-	-2, 443,
-	-1, 793,
+	-2, 447,
+	-1, 806,
 	5, 23,
-	-2, 320,
-	-1, 967,
+	-2, 324,
+	-1, 980,
 	5, 23,
-	-2, 444,
-	-1, 1015,
+	-2, 448,
+	-1, 1028,
 	5, 22,
-	-2, 446,
-	-1, 1061,
+	-2, 450,
+	-1, 1074,
 	5, 23,
-	-2, 447,
+	-2, 451,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 8494
+const yyLast = 8392
 
 var yyAct = [...]int{
-
-	380, 38, 1053, 565, 379, 885, 353, 908, 198, 664,
-	624, 886, 641, 677, 882, 943, 973, 173, 432, 429,
-	44, 736, 743, 782, 430, 3, 746, 856, 240, 848,
-	242, 640, 637, 797, 760, 818, 713, 650, 238, 38,
-	167, 785, 342, 402, 408, 213, 348, 178, 201, 434,
-	603, 351, 193, 189, 745, 418, 182, 673, 43, 1084,
-	207, 1075, 1081, 172, 1070, 1079, 1074, 1069, 956, 1008,
-	217, 187, 1030, 814, 188, 168, 169, 170, 171, 913,
-	914, 915, 340, 657, 985, 233, 665, 48, 916, 1003,
-	693, 1056, 531, 530, 540, 541, 533, 534, 535, 536,
-	537, 538, 539, 532, 691, 1036, 542, 1001, 50, 51,
-	52, 53, 334, 335, 1078, 223, 1076, 1054, 531, 530,
-	540, 541, 533, 534, 535, 536, 537, 538, 539, 532,
-	838, 697, 542, 604, 224, 625, 627, 219, 658, 135,
-	690, 136, 492, 136, 497, 220, 235, 1028, 237, 138,
-	139, 140, 239, 239, 239, 239, 849, 239, 239, 796,
-	795, 794, 748, 215, 239, 577, 835, 234, 236, 147,
-	137, 1046, 837, 652, 554, 555, 993, 970, 860, 38,
-	801, 564, 450, 228, 819, 542, 517, 652, 687, 692,
-	685, 331, 332, 333, 431, 336, 337, 404, 520, 761,
-	449, 808, 339, 405, 958, 720, 207, 626, 444, 695,
-	698, 494, 1057, 665, 917, 214, 153, 239, 922, 718,
-	719, 717, 239, 232, 532, 518, 1049, 542, 1068, 446,
-	812, 239, 239, 239, 239, 239, 239, 239, 239, 689,
-	163, 520, 1029, 1027, 944, 410, 535, 536, 537, 538,
-	539, 532, 654, 688, 542, 491, 226, 655, 989, 504,
-	496, 502, 836, 651, 834, 988, 946, 406, 923, 505,
-	506, 507, 508, 509, 510, 511, 512, 651, 694, 761,
-	867, 872, 649, 648, 41, 827, 519, 518, 826, 696,
-	148, 522, 815, 948, 716, 952, 150, 947, 1063, 945,
-	211, 156, 152, 520, 950, 533, 534, 535, 536, 537,
-	538, 539, 532, 949, 1039, 542, 592, 593, 951, 953,
-	987, 866, 154, 865, 825, 158, 63, 521, 519, 518,
-	145, 921, 737, 145, 738, 239, 239, 345, 403, 519,
-	518, 1065, 341, 519, 518, 520, 910, 809, 149, 739,
-	551, 553, 145, 145, 1019, 341, 520, 490, 145, 230,
-	520, 225, 519, 518, 841, 842, 843, 151, 157, 159,
-	160, 161, 162, 513, 514, 165, 164, 214, 563, 520,
-	341, 567, 568, 569, 570, 571, 572, 573, 1032, 576,
-	578, 578, 578, 578, 578, 578, 578, 578, 586, 587,
-	588, 589, 1031, 556, 557, 558, 559, 560, 561, 562,
-	706, 708, 709, 1019, 1020, 707, 193, 193, 193, 193,
-	193, 207, 594, 606, 519, 518, 982, 981, 902, 341,
-	883, 960, 431, 443, 628, 918, 145, 443, 145, 355,
-	193, 520, 145, 969, 341, 854, 341, 751, 145, 965,
-	207, 415, 63, 63, 63, 63, 623, 63, 63, 631,
-	928, 927, 881, 596, 63, 925, 924, 751, 341, 45,
-	608, 609, 783, 611, 666, 667, 668, 415, 341, 636,
-	642, 638, 619, 452, 451, 629, 634, 145, 595, 630,
-	607, 192, 633, 610, 145, 145, 145, 783, 645, 926,
-	19, 63, 239, 679, 414, 854, 441, 19, 854, 854,
-	523, 802, 621, 622, 415, 590, 41, 63, 179, 145,
-	659, 145, 63, 777, 145, 678, 778, 145, 415, 145,
-	714, 63, 63, 63, 63, 63, 63, 63, 63, 443,
-	701, 896, 805, 566, 675, 676, 41, 442, 681, 440,
-	575, 674, 669, 41, 38, 55, 579, 580, 581, 582,
-	583, 584, 585, 19, 41, 786, 787, 912, 567, 134,
-	883, 712, 828, 789, 721, 722, 723, 724, 725, 726,
-	727, 728, 729, 730, 731, 732, 733, 734, 735, 1014,
-	500, 338, 623, 750, 618, 638, 424, 425, 660, 661,
-	662, 663, 17, 616, 780, 781, 740, 741, 617, 41,
-	765, 614, 635, 670, 671, 672, 615, 602, 758, 792,
-	186, 791, 613, 753, 754, 612, 1077, 757, 779, 183,
-	184, 1073, 840, 192, 768, 63, 63, 769, 702, 145,
-	1072, 764, 774, 766, 767, 409, 773, 820, 752, 177,
-	343, 448, 790, 803, 799, 800, 775, 231, 811, 407,
-	1051, 763, 344, 368, 367, 370, 371, 372, 373, 642,
-	816, 817, 369, 374, 1050, 1012, 806, 963, 991, 683,
-	499, 703, 704, 239, 710, 711, 428, 807, 540, 541,
-	533, 534, 535, 536, 537, 538, 539, 532, 180, 181,
-	542, 239, 821, 822, 823, 409, 793, 772, 63, 174,
-	1042, 175, 45, 145, 1041, 771, 145, 145, 145, 145,
-	145, 830, 714, 1011, 783, 831, 1043, 986, 145, 516,
-	566, 47, 145, 755, 756, 49, 145, 439, 42, 839,
-	145, 145, 420, 423, 424, 425, 421, 1, 422, 426,
-	686, 861, 63, 403, 844, 1052, 907, 647, 639, 212,
-	54, 646, 824, 1026, 984, 845, 846, 847, 653, 813,
-	656, 911, 1048, 810, 455, 456, 454, 458, 457, 888,
-	453, 38, 155, 887, 207, 199, 884, 427, 445, 855,
-	552, 680, 56, 145, 871, 898, 899, 900, 145, 853,
-	833, 145, 63, 893, 832, 889, 684, 218, 550, 770,
-	200, 890, 591, 869, 401, 892, 1040, 906, 1010, 870,
-	903, 574, 851, 759, 354, 705, 852, 366, 363, 365,
-	904, 642, 364, 642, 905, 597, 863, 864, 776, 524,
-	868, 352, 346, 191, 411, 874, 419, 875, 876, 877,
-	878, 417, 919, 920, 416, 192, 192, 192, 192, 192,
-	196, 788, 784, 63, 190, 880, 1007, 1055, 601, 20,
-	46, 192, 185, 16, 931, 933, 15, 63, 14, 192,
-	901, 938, 193, 955, 942, 939, 13, 24, 954, 750,
-	957, 638, 12, 941, 11, 10, 935, 936, 9, 964,
-	8, 962, 7, 6, 961, 5, 4, 176, 18, 377,
-	2, 972, 0, 0, 862, 975, 976, 977, 803, 0,
-	978, 980, 0, 0, 873, 0, 0, 0, 0, 63,
-	239, 0, 0, 0, 642, 61, 937, 0, 0, 0,
-	0, 63, 0, 0, 0, 0, 894, 0, 0, 895,
-	0, 0, 897, 0, 0, 0, 0, 1006, 0, 0,
-	0, 0, 999, 208, 0, 715, 888, 0, 990, 1016,
-	887, 966, 967, 968, 0, 971, 0, 1013, 0, 0,
-	0, 992, 63, 63, 0, 0, 0, 0, 0, 1024,
-	1025, 0, 1033, 1015, 0, 0, 0, 0, 0, 0,
-	0, 63, 420, 423, 424, 425, 421, 1035, 422, 426,
-	0, 0, 786, 787, 0, 888, 0, 38, 0, 887,
-	1044, 0, 904, 0, 0, 994, 995, 0, 0, 0,
-	0, 0, 0, 0, 0, 959, 0, 1004, 1005, 0,
-	0, 1045, 0, 0, 0, 0, 1059, 0, 983, 63,
-	207, 566, 1060, 1037, 0, 0, 0, 0, 1021, 1022,
-	1023, 241, 241, 241, 241, 0, 241, 241, 1071, 0,
-	0, 145, 0, 241, 0, 0, 0, 0, 0, 1080,
-	0, 63, 63, 996, 997, 0, 998, 0, 0, 1000,
-	1038, 1002, 0, 0, 0, 194, 0, 0, 0, 0,
-	0, 0, 63, 63, 0, 63, 63, 0, 0, 208,
-	241, 530, 540, 541, 533, 534, 535, 536, 537, 538,
-	539, 532, 0, 1009, 542, 142, 241, 1082, 1061, 145,
-	0, 241, 0, 145, 341, 1064, 0, 0, 1067, 63,
-	241, 241, 241, 241, 241, 241, 241, 241, 197, 0,
-	0, 0, 0, 216, 0, 0, 0, 715, 63, 0,
-	0, 0, 378, 0, 0, 1085, 1086, 0, 0, 0,
-	531, 530, 540, 541, 533, 534, 535, 536, 537, 538,
-	539, 532, 145, 0, 542, 0, 0, 0, 0, 0,
-	0, 0, 143, 0, 0, 166, 0, 63, 0, 63,
-	63, 63, 145, 63, 1058, 566, 63, 0, 0, 0,
-	0, 0, 0, 0, 143, 143, 209, 0, 0, 0,
-	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	63, 221, 0, 222, 0, 0, 0, 227, 0, 526,
-	0, 529, 0, 229, 241, 241, 0, 543, 544, 545,
-	546, 547, 548, 549, 0, 527, 528, 525, 531, 530,
-	540, 541, 533, 534, 535, 536, 537, 538, 539, 532,
-	63, 63, 542, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 413, 63, 0, 0, 0, 0, 0, 0,
-	0, 438, 0, 0, 63, 0, 0, 0, 143, 0,
-	143, 0, 0, 0, 143, 0, 0, 0, 0, 0,
-	143, 0, 0, 0, 493, 0, 495, 598, 0, 498,
-	63, 192, 501, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 19, 39, 21, 22,
-	0, 0, 0, 934, 0, 0, 0, 63, 0, 143,
-	0, 0, 0, 208, 33, 63, 143, 436, 143, 23,
-	241, 241, 209, 531, 530, 540, 541, 533, 534, 535,
-	536, 537, 538, 539, 532, 850, 0, 542, 32, 0,
-	0, 143, 41, 143, 0, 0, 143, 0, 0, 143,
-	0, 503, 0, 0, 0, 531, 530, 540, 541, 533,
-	534, 535, 536, 537, 538, 539, 532, 0, 0, 542,
-	0, 241, 531, 530, 540, 541, 533, 534, 535, 536,
-	537, 538, 539, 532, 0, 0, 542, 0, 0, 0,
-	0, 0, 0, 0, 515, 0, 0, 0, 0, 0,
-	0, 25, 26, 28, 27, 30, 0, 0, 0, 0,
-	0, 0, 0, 0, 31, 34, 35, 0, 0, 36,
-	37, 29, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 742, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 762, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 0, 0, 0, 0, 0, 605, 0,
+	388, 38, 1066, 575, 898, 348, 361, 921, 634, 203,
+	438, 3, 899, 687, 674, 986, 440, 651, 178, 956,
+	869, 749, 387, 895, 761, 58, 759, 861, 647, 795,
+	756, 650, 660, 246, 810, 437, 172, 726, 773, 38,
+	798, 410, 350, 416, 359, 218, 831, 183, 248, 177,
+	206, 363, 198, 683, 613, 187, 43, 426, 250, 442,
+	212, 1097, 1088, 1094, 1083, 1092, 1087, 969, 1082, 44,
+	1021, 173, 174, 175, 176, 224, 926, 927, 928, 1043,
+	827, 667, 241, 998, 675, 929, 193, 192, 1049, 1016,
+	1014, 1091, 231, 342, 343, 1089, 139, 1067, 141, 194,
+	48, 851, 614, 197, 1069, 541, 540, 550, 551, 543,
+	544, 545, 546, 547, 548, 549, 542, 140, 232, 552,
+	662, 50, 51, 52, 53, 226, 141, 1041, 635, 637,
+	143, 144, 145, 227, 501, 848, 704, 507, 935, 809,
+	808, 850, 662, 243, 152, 245, 807, 222, 219, 1059,
+	702, 142, 564, 565, 1006, 983, 873, 247, 247, 247,
+	247, 668, 247, 247, 242, 244, 758, 587, 814, 247,
+	821, 545, 546, 547, 548, 549, 542, 710, 574, 552,
+	458, 236, 542, 832, 38, 552, 701, 527, 936, 552,
+	528, 339, 340, 341, 413, 344, 345, 675, 221, 439,
+	636, 971, 347, 733, 353, 411, 530, 530, 529, 528,
+	661, 212, 930, 56, 452, 659, 658, 731, 732, 730,
+	457, 240, 1042, 1040, 247, 530, 1070, 774, 503, 247,
+	1081, 849, 661, 847, 698, 703, 696, 957, 234, 247,
+	247, 247, 247, 247, 247, 247, 247, 774, 57, 885,
+	197, 412, 532, 708, 454, 706, 711, 500, 825, 959,
+	1062, 707, 505, 529, 528, 512, 506, 418, 1002, 880,
+	973, 1001, 515, 516, 517, 518, 519, 520, 521, 522,
+	530, 854, 855, 856, 840, 414, 700, 961, 531, 965,
+	839, 960, 41, 958, 216, 514, 664, 879, 963, 878,
+	699, 665, 729, 828, 529, 528, 750, 962, 751, 1076,
+	356, 1052, 964, 966, 1000, 529, 528, 529, 528, 838,
+	66, 530, 1078, 349, 150, 705, 349, 150, 349, 1032,
+	349, 1045, 530, 934, 530, 923, 709, 719, 721, 722,
+	1032, 1033, 720, 247, 247, 822, 150, 150, 995, 994,
+	915, 349, 150, 982, 349, 150, 867, 349, 561, 563,
+	941, 940, 938, 937, 541, 540, 550, 551, 543, 544,
+	545, 546, 547, 548, 549, 542, 523, 524, 552, 752,
+	533, 764, 349, 423, 349, 1044, 573, 499, 238, 577,
+	578, 579, 580, 581, 582, 583, 233, 586, 588, 588,
+	588, 588, 588, 588, 588, 588, 596, 597, 598, 599,
+	562, 460, 459, 576, 219, 931, 45, 894, 449, 605,
+	585, 602, 603, 19, 198, 198, 198, 198, 198, 212,
+	896, 604, 616, 451, 150, 150, 451, 150, 796, 19,
+	439, 150, 638, 631, 632, 764, 790, 150, 198, 791,
+	633, 66, 66, 66, 66, 867, 66, 66, 212, 450,
+	867, 448, 978, 66, 423, 1027, 939, 529, 528, 41,
+	606, 867, 796, 422, 815, 197, 197, 197, 197, 197,
+	423, 600, 645, 641, 530, 41, 150, 676, 677, 678,
+	644, 197, 629, 150, 150, 150, 19, 423, 184, 197,
+	66, 640, 643, 639, 690, 41, 655, 646, 652, 669,
+	617, 689, 247, 620, 451, 688, 150, 648, 66, 909,
+	150, 818, 150, 66, 618, 619, 150, 621, 684, 150,
+	679, 150, 692, 66, 66, 66, 66, 66, 66, 66,
+	66, 925, 41, 896, 41, 714, 799, 800, 685, 686,
+	138, 841, 802, 716, 717, 510, 723, 724, 727, 346,
+	17, 612, 805, 804, 38, 623, 589, 590, 591, 592,
+	593, 594, 595, 428, 431, 432, 433, 429, 577, 430,
+	434, 765, 622, 799, 800, 188, 189, 728, 633, 428,
+	431, 432, 433, 429, 776, 430, 434, 628, 626, 432,
+	433, 191, 576, 627, 1090, 768, 769, 182, 624, 753,
+	754, 1086, 853, 625, 793, 794, 715, 1085, 787, 786,
+	417, 833, 456, 763, 792, 411, 239, 771, 778, 670,
+	671, 672, 673, 648, 415, 1064, 351, 66, 66, 806,
+	824, 150, 781, 1063, 680, 681, 682, 782, 352, 541,
+	540, 550, 551, 543, 544, 545, 546, 547, 548, 549,
+	542, 803, 1025, 552, 819, 976, 1004, 694, 816, 509,
+	812, 813, 436, 417, 385, 566, 567, 568, 569, 570,
+	571, 572, 185, 186, 179, 829, 830, 862, 785, 1055,
+	180, 45, 820, 1054, 247, 1024, 784, 796, 1056, 652,
+	64, 543, 544, 545, 546, 547, 548, 549, 542, 999,
+	66, 552, 526, 47, 247, 150, 49, 447, 150, 150,
+	150, 150, 150, 834, 835, 836, 42, 843, 213, 1,
+	150, 55, 844, 697, 150, 1065, 920, 657, 150, 649,
+	217, 54, 150, 150, 656, 766, 767, 852, 837, 770,
+	1039, 997, 663, 727, 66, 826, 666, 924, 864, 1061,
+	823, 463, 865, 777, 874, 779, 780, 464, 857, 462,
+	466, 465, 876, 877, 461, 160, 881, 204, 788, 435,
+	453, 887, 728, 888, 889, 890, 891, 868, 691, 875,
+	59, 846, 901, 845, 38, 695, 150, 212, 225, 886,
+	897, 560, 150, 783, 902, 150, 66, 205, 911, 912,
+	913, 884, 903, 601, 900, 409, 914, 1053, 1023, 883,
+	584, 907, 772, 362, 908, 718, 374, 910, 371, 905,
+	373, 249, 249, 249, 249, 919, 249, 249, 372, 607,
+	789, 534, 360, 249, 354, 725, 196, 918, 734, 735,
+	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
+	746, 747, 748, 917, 652, 906, 652, 66, 419, 427,
+	425, 424, 950, 201, 801, 797, 932, 933, 195, 213,
+	249, 66, 916, 944, 893, 1020, 946, 1068, 611, 20,
+	46, 952, 190, 16, 951, 198, 15, 14, 249, 13,
+	968, 967, 954, 249, 24, 955, 12, 979, 980, 981,
+	972, 984, 11, 249, 249, 249, 249, 249, 249, 249,
+	249, 977, 763, 974, 866, 985, 576, 988, 989, 990,
+	975, 991, 648, 66, 10, 993, 816, 9, 882, 8,
+	7, 6, 5, 247, 4, 66, 197, 181, 18, 2,
+	0, 0, 970, 0, 376, 375, 378, 379, 380, 381,
+	0, 1007, 1008, 377, 382, 0, 0, 652, 0, 0,
+	1019, 0, 0, 1017, 1018, 1012, 1003, 0, 0, 901,
+	0, 0, 1029, 0, 0, 0, 0, 66, 66, 0,
+	0, 1026, 1028, 0, 1034, 1035, 1036, 0, 1022, 0,
+	1037, 900, 0, 1038, 0, 1046, 0, 0, 66, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 249, 0,
+	0, 0, 0, 0, 0, 1048, 1051, 0, 901, 0,
+	38, 0, 1057, 0, 0, 0, 0, 0, 0, 0,
+	1058, 0, 858, 859, 860, 0, 0, 0, 0, 0,
+	900, 0, 0, 0, 0, 917, 66, 0, 0, 1072,
+	0, 0, 0, 212, 1074, 0, 1073, 0, 0, 0,
+	0, 1077, 0, 0, 1080, 0, 0, 0, 150, 1071,
+	576, 1084, 0, 0, 996, 0, 0, 0, 66, 66,
+	608, 0, 1093, 0, 0, 0, 0, 213, 0, 0,
+	0, 1098, 1099, 0, 0, 0, 0, 0, 0, 66,
+	66, 0, 66, 66, 0, 0, 0, 0, 0, 1009,
+	1010, 0, 1011, 0, 0, 1013, 213, 1015, 947, 0,
+	0, 0, 0, 249, 249, 0, 150, 0, 0, 0,
+	150, 0, 0, 0, 0, 0, 66, 0, 541, 540,
+	550, 551, 543, 544, 545, 546, 547, 548, 549, 542,
+	0, 0, 552, 0, 199, 66, 0, 0, 0, 0,
+	0, 0, 0, 948, 949, 540, 550, 551, 543, 544,
+	545, 546, 547, 548, 549, 542, 249, 0, 552, 150,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 386, 0, 66, 0, 66, 66, 66, 150,
+	66, 0, 0, 66, 0, 0, 0, 202, 0, 0,
+	0, 0, 220, 0, 0, 223, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 171, 0, 66, 0, 0,
+	0, 0, 0, 0, 0, 863, 0, 755, 0, 249,
+	0, 0, 0, 0, 148, 148, 214, 0, 1005, 0,
+	148, 775, 0, 148, 0, 541, 540, 550, 551, 543,
+	544, 545, 546, 547, 548, 549, 542, 66, 66, 552,
+	550, 551, 543, 544, 545, 546, 547, 548, 549, 542,
+	66, 0, 552, 0, 0, 0, 0, 0, 0, 0,
+	0, 66, 0, 0, 228, 0, 0, 230, 0, 0,
+	0, 235, 0, 811, 0, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 66, 0, 0,
+	1050, 0, 0, 0, 0, 19, 39, 21, 22, 0,
+	0, 0, 148, 229, 0, 148, 158, 0, 0, 148,
+	0, 0, 0, 33, 66, 148, 421, 0, 23, 0,
+	0, 0, 66, 0, 0, 446, 0, 842, 249, 0,
+	168, 0, 0, 0, 0, 0, 0, 32, 0, 0,
+	0, 41, 0, 0, 0, 0, 498, 0, 249, 0,
+	502, 0, 504, 0, 148, 0, 508, 0, 0, 511,
+	0, 148, 444, 148, 1095, 0, 0, 214, 541, 540,
+	550, 551, 543, 544, 545, 546, 547, 548, 549, 542,
+	153, 0, 552, 0, 148, 0, 155, 0, 148, 0,
+	148, 161, 157, 0, 148, 0, 871, 148, 0, 513,
+	25, 26, 28, 27, 30, 0, 0, 0, 0, 0,
+	0, 0, 159, 31, 34, 35, 163, 0, 36, 37,
+	29, 0, 0, 0, 0, 213, 0, 0, 904, 811,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	249, 0, 249, 922, 0, 0, 0, 0, 156, 162,
+	164, 165, 166, 167, 0, 0, 170, 169, 0, 0,
+	0, 525, 469, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 945, 0, 0, 0,
+	0, 40, 0, 0, 481, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 871, 0, 0, 249, 148,
+	0, 486, 487, 488, 489, 490, 491, 492, 0, 493,
+	494, 495, 496, 497, 482, 483, 484, 485, 467, 468,
+	0, 0, 470, 0, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 987, 615, 987, 987, 987, 0,
+	992, 0, 0, 249, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 642, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 148, 0, 214, 148, 148, 148, 148,
+	148, 0, 0, 0, 0, 0, 0, 0, 630, 0,
+	0, 0, 148, 0, 0, 0, 444, 0, 0, 0,
+	148, 148, 0, 0, 214, 0, 0, 1030, 1031, 0,
+	0, 513, 0, 0, 0, 0, 693, 0, 0, 0,
+	922, 0, 712, 0, 0, 713, 0, 0, 0, 0,
+	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 40, 0, 0, 0, 0, 632, 0, 798, 0,
+	0, 0, 0, 0, 148, 0, 0, 1060, 0, 0,
+	148, 0, 0, 148, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 0, 1075, 0, 0, 0, 0, 0,
+	0, 0, 1079, 0, 0, 101, 0, 0, 0, 0,
+	0, 0, 0, 0, 80, 0, 0, 0, 0, 88,
+	0, 90, 0, 0, 111, 97, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 762, 513, 0, 0,
+	0, 762, 762, 65, 0, 762, 0, 0, 0, 0,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 762,
+	762, 762, 762, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 762, 0, 0, 0, 541, 540,
+	550, 551, 543, 544, 545, 546, 547, 548, 549, 542,
+	0, 0, 552, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 151, 0, 0, 0,
+	0, 104, 0, 0, 0, 76, 0, 109, 102, 0,
+	0, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 0, 0, 112, 124,
+	137, 0, 0, 131, 132, 133, 134, 98, 74, 84,
+	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 67, 0, 89, 135,
+	106, 82, 125, 0, 0, 0, 0, 0, 892, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 0, 209, 143, 143,
-	143, 143, 143, 461, 0, 0, 0, 0, 682, 0,
-	620, 829, 241, 699, 143, 0, 700, 0, 436, 0,
-	0, 0, 143, 143, 0, 473, 209, 0, 0, 0,
-	241, 0, 0, 503, 0, 0, 0, 0, 0, 0,
-	0, 478, 479, 480, 481, 482, 483, 484, 0, 485,
-	486, 487, 488, 489, 474, 475, 476, 477, 459, 460,
-	0, 0, 462, 0, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 0, 143, 0, 0, 858, 0,
-	143, 0, 0, 143, 0, 0, 0, 0, 0, 0,
+	762, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 762, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 536, 0, 539, 942, 0, 0, 0,
+	943, 553, 554, 555, 556, 557, 558, 559, 0, 537,
+	538, 535, 541, 540, 550, 551, 543, 544, 545, 546,
+	547, 548, 549, 542, 0, 0, 552, 0, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 148, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 0,
-	891, 798, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 241, 0, 241, 909, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 749, 503, 0, 0,
-	0, 749, 749, 0, 0, 749, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 932, 749,
-	749, 749, 749, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 749, 0, 0, 858, 0, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	762, 0, 0, 0, 0, 0, 513, 762, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 974, 0, 974, 974,
-	974, 0, 979, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 444, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 879, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1017,
-	1018, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 909, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 749, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 749, 0, 0, 929, 0, 0, 0, 930, 1047,
-	0, 0, 0, 143, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 0, 0, 1062, 0, 0, 0,
-	0, 0, 0, 0, 1066, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 0, 0, 143, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 749, 0, 0,
-	0, 0, 0, 503, 749, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 65, 0, 653, 654, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 817, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 214,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 65, 0, 653, 654, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 1047, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	41, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 953, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 65, 0, 455, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 328, 318, 291, 330, 269, 283, 338,
+	284, 285, 312, 257, 299, 101, 281, 0, 272, 252,
+	278, 253, 270, 293, 80, 296, 268, 320, 302, 88,
+	336, 90, 307, 0, 111, 97, 0, 0, 295, 322,
+	297, 317, 290, 313, 262, 306, 331, 282, 310, 332,
+	0, 0, 0, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 309, 327, 280, 311, 251, 308,
+	0, 255, 258, 337, 325, 275, 276, 0, 0, 0,
+	0, 0, 0, 0, 294, 298, 314, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 305, 0,
+	0, 0, 259, 256, 0, 292, 0, 0, 0, 261,
+	0, 274, 315, 0, 323, 289, 151, 326, 287, 286,
+	329, 104, 321, 271, 279, 76, 277, 109, 102, 0,
+	304, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 254, 0, 112, 124,
+	137, 267, 324, 131, 132, 133, 134, 98, 74, 84,
+	110, 265, 266, 263, 264, 300, 301, 333, 334, 335,
+	316, 260, 0, 0, 319, 303, 67, 0, 89, 135,
+	106, 82, 125, 101, 0, 0, 757, 0, 358, 0,
+	0, 0, 80, 0, 357, 0, 0, 88, 396, 90,
+	0, 0, 111, 97, 0, 0, 0, 0, 389, 390,
+	0, 0, 0, 0, 0, 0, 0, 0, 41, 0,
+	0, 215, 376, 375, 378, 379, 380, 381, 0, 0,
+	75, 377, 382, 383, 384, 0, 0, 355, 369, 0,
+	395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 436, 0, 0, 0, 0, 0,
+	366, 367, 760, 0, 0, 0, 407, 0, 368, 0,
+	0, 364, 365, 370, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 405, 0, 104,
+	0, 0, 0, 76, 0, 109, 102, 0, 0, 103,
+	108, 91, 117, 105, 123, 115, 129, 130, 114, 128,
+	69, 121, 113, 95, 85, 86, 68, 0, 107, 79,
+	83, 78, 100, 118, 119, 77, 136, 72, 127, 71,
+	73, 126, 99, 116, 122, 96, 93, 70, 120, 94,
+	92, 87, 81, 0, 0, 0, 112, 124, 137, 0,
+	0, 131, 132, 133, 134, 98, 74, 84, 110, 397,
+	406, 403, 404, 401, 402, 400, 399, 398, 408, 391,
+	392, 394, 0, 393, 67, 0, 89, 135, 106, 82,
+	125, 101, 0, 0, 0, 0, 358, 0, 0, 0,
+	80, 0, 357, 0, 0, 88, 396, 90, 0, 0,
+	111, 97, 0, 0, 0, 0, 389, 390, 0, 0,
+	0, 0, 0, 0, 0, 0, 41, 0, 349, 215,
+	376, 375, 378, 379, 380, 381, 0, 0, 75, 377,
+	382, 383, 384, 0, 0, 355, 369, 0, 395, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	320, 310, 283, 322, 261, 275, 330, 276, 277, 304,
-	249, 291, 98, 273, 0, 264, 244, 270, 245, 262,
-	285, 77, 288, 260, 312, 294, 85, 328, 87, 299,
-	0, 108, 94, 0, 0, 287, 314, 289, 309, 282,
-	305, 254, 298, 323, 274, 302, 324, 0, 0, 0,
-	62, 0, 643, 644, 0, 0, 0, 0, 0, 72,
-	0, 301, 319, 272, 303, 243, 300, 0, 247, 250,
-	329, 317, 267, 268, 804, 0, 0, 0, 0, 0,
-	0, 286, 290, 306, 280, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 297, 0, 0, 0, 251,
-	248, 0, 284, 0, 0, 0, 253, 0, 266, 307,
-	0, 315, 281, 146, 318, 279, 278, 321, 101, 313,
-	263, 271, 73, 269, 106, 99, 209, 296, 100, 105,
-	88, 113, 102, 119, 125, 126, 111, 124, 66, 117,
-	110, 92, 82, 83, 65, 0, 104, 76, 80, 75,
-	97, 114, 115, 74, 132, 69, 123, 68, 70, 122,
-	96, 112, 118, 93, 90, 67, 116, 91, 89, 84,
-	78, 0, 246, 0, 109, 120, 133, 259, 316, 127,
-	128, 129, 130, 95, 71, 81, 107, 257, 258, 255,
-	256, 292, 293, 325, 326, 327, 308, 252, 0, 0,
-	311, 295, 64, 0, 86, 131, 103, 79, 121, 320,
-	310, 283, 322, 261, 275, 330, 276, 277, 304, 249,
-	291, 98, 273, 0, 264, 244, 270, 245, 262, 285,
-	77, 288, 260, 312, 294, 85, 328, 87, 299, 0,
-	108, 94, 0, 0, 287, 314, 289, 309, 282, 305,
-	254, 298, 323, 274, 302, 324, 0, 0, 0, 62,
-	0, 643, 644, 0, 0, 0, 0, 0, 72, 0,
-	301, 319, 272, 303, 243, 300, 0, 247, 250, 329,
-	317, 267, 268, 0, 0, 0, 0, 0, 0, 0,
-	286, 290, 306, 280, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 297, 0, 0, 0, 251, 248,
-	0, 284, 0, 0, 0, 253, 0, 266, 307, 0,
-	315, 281, 146, 318, 279, 278, 321, 101, 313, 263,
-	271, 73, 269, 106, 99, 0, 296, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	0, 246, 0, 109, 120, 133, 259, 316, 127, 128,
-	129, 130, 95, 71, 81, 107, 257, 258, 255, 256,
-	292, 293, 325, 326, 327, 308, 252, 0, 0, 311,
-	295, 64, 0, 86, 131, 103, 79, 121, 320, 310,
-	283, 322, 261, 275, 330, 276, 277, 304, 249, 291,
-	98, 273, 0, 264, 244, 270, 245, 262, 285, 77,
-	288, 260, 312, 294, 85, 328, 87, 299, 0, 108,
-	94, 0, 0, 287, 314, 289, 309, 282, 305, 254,
-	298, 323, 274, 302, 324, 0, 0, 0, 62, 0,
-	0, 0, 0, 0, 0, 0, 0, 72, 0, 301,
-	319, 272, 303, 243, 300, 0, 247, 250, 329, 317,
-	267, 268, 0, 0, 0, 0, 0, 0, 0, 286,
-	290, 306, 280, 0, 0, 0, 0, 0, 0, 1034,
-	0, 265, 0, 297, 0, 0, 0, 251, 248, 0,
-	284, 0, 0, 0, 253, 0, 266, 307, 0, 315,
-	281, 146, 318, 279, 278, 321, 101, 313, 263, 271,
-	73, 269, 106, 99, 0, 296, 100, 105, 88, 113,
-	102, 119, 125, 126, 111, 124, 66, 117, 110, 92,
-	82, 83, 65, 0, 104, 76, 80, 75, 97, 114,
-	115, 74, 132, 69, 123, 68, 70, 122, 96, 112,
-	118, 93, 90, 67, 116, 91, 89, 84, 78, 0,
-	246, 0, 109, 120, 133, 259, 316, 127, 128, 129,
-	130, 95, 71, 81, 107, 257, 258, 255, 256, 292,
-	293, 325, 326, 327, 308, 252, 0, 0, 311, 295,
-	64, 0, 86, 131, 103, 79, 121, 320, 310, 283,
-	322, 261, 275, 330, 276, 277, 304, 249, 291, 98,
-	273, 0, 264, 244, 270, 245, 262, 285, 77, 288,
-	260, 312, 294, 85, 328, 87, 299, 0, 108, 94,
-	0, 0, 287, 314, 289, 309, 282, 305, 254, 298,
-	323, 274, 302, 324, 41, 0, 0, 62, 0, 0,
-	0, 0, 0, 0, 0, 0, 72, 0, 301, 319,
-	272, 303, 243, 300, 0, 247, 250, 329, 317, 267,
-	268, 0, 0, 0, 0, 0, 0, 0, 286, 290,
-	306, 280, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 297, 0, 0, 0, 251, 248, 0, 284,
-	0, 0, 0, 253, 0, 266, 307, 0, 315, 281,
-	146, 318, 279, 278, 321, 101, 313, 263, 271, 73,
-	269, 106, 99, 0, 296, 100, 105, 88, 113, 102,
-	119, 125, 126, 111, 124, 66, 117, 110, 92, 82,
-	83, 65, 0, 104, 76, 80, 75, 97, 114, 115,
-	74, 132, 69, 123, 68, 70, 122, 96, 112, 118,
-	93, 90, 67, 116, 91, 89, 84, 78, 0, 246,
-	0, 109, 120, 133, 259, 316, 127, 128, 129, 130,
-	95, 71, 81, 107, 257, 258, 255, 256, 292, 293,
-	325, 326, 327, 308, 252, 0, 0, 311, 295, 64,
-	0, 86, 131, 103, 79, 121, 320, 310, 283, 322,
-	261, 275, 330, 276, 277, 304, 249, 291, 98, 273,
-	0, 264, 244, 270, 245, 262, 285, 77, 288, 260,
-	312, 294, 85, 328, 87, 299, 0, 108, 94, 0,
-	0, 287, 314, 289, 309, 282, 305, 254, 298, 323,
-	274, 302, 324, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 72, 0, 301, 319, 272,
-	303, 243, 300, 0, 247, 250, 329, 317, 267, 268,
-	0, 0, 0, 0, 0, 0, 0, 286, 290, 306,
-	280, 0, 0, 0, 0, 0, 0, 940, 0, 265,
-	0, 297, 0, 0, 0, 251, 248, 0, 284, 0,
-	0, 0, 253, 0, 266, 307, 0, 315, 281, 146,
-	318, 279, 278, 321, 101, 313, 263, 271, 73, 269,
-	106, 99, 0, 296, 100, 105, 88, 113, 102, 119,
-	125, 126, 111, 124, 66, 117, 110, 92, 82, 83,
-	65, 0, 104, 76, 80, 75, 97, 114, 115, 74,
-	132, 69, 123, 68, 70, 122, 96, 112, 118, 93,
-	90, 67, 116, 91, 89, 84, 78, 0, 246, 0,
-	109, 120, 133, 259, 316, 127, 128, 129, 130, 95,
-	71, 81, 107, 257, 258, 255, 256, 292, 293, 325,
-	326, 327, 308, 252, 0, 0, 311, 295, 64, 0,
-	86, 131, 103, 79, 121, 320, 310, 283, 322, 261,
-	275, 330, 276, 277, 304, 249, 291, 98, 273, 0,
-	264, 244, 270, 245, 262, 285, 77, 288, 260, 312,
-	294, 85, 328, 87, 299, 0, 108, 94, 0, 0,
-	287, 314, 289, 309, 282, 305, 254, 298, 323, 274,
-	302, 324, 0, 0, 0, 62, 0, 447, 0, 0,
-	0, 0, 0, 0, 72, 0, 301, 319, 272, 303,
-	243, 300, 0, 247, 250, 329, 317, 267, 268, 0,
-	0, 0, 0, 0, 0, 0, 286, 290, 306, 280,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	297, 0, 0, 0, 251, 248, 0, 284, 0, 0,
-	0, 253, 0, 266, 307, 0, 315, 281, 146, 318,
-	279, 278, 321, 101, 313, 263, 271, 73, 269, 106,
-	99, 0, 296, 100, 105, 88, 113, 102, 119, 125,
-	126, 111, 124, 66, 117, 110, 92, 82, 83, 65,
-	0, 104, 76, 80, 75, 97, 114, 115, 74, 132,
-	69, 123, 68, 70, 122, 96, 112, 118, 93, 90,
-	67, 116, 91, 89, 84, 78, 0, 246, 0, 109,
-	120, 133, 259, 316, 127, 128, 129, 130, 95, 71,
-	81, 107, 257, 258, 255, 256, 292, 293, 325, 326,
-	327, 308, 252, 0, 0, 311, 295, 64, 0, 86,
-	131, 103, 79, 121, 320, 310, 283, 322, 261, 275,
-	330, 276, 277, 304, 249, 291, 98, 273, 0, 264,
-	244, 270, 245, 262, 285, 77, 288, 260, 312, 294,
-	85, 328, 87, 299, 0, 108, 94, 0, 0, 287,
-	314, 289, 309, 282, 305, 254, 298, 323, 274, 302,
-	324, 0, 0, 0, 62, 0, 0, 0, 0, 0,
-	0, 0, 0, 72, 0, 301, 319, 272, 303, 243,
-	300, 0, 247, 250, 329, 317, 267, 268, 0, 0,
-	0, 0, 0, 0, 0, 286, 290, 306, 280, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 297,
-	0, 0, 0, 251, 248, 0, 284, 0, 0, 0,
-	253, 0, 266, 307, 0, 315, 281, 146, 318, 279,
-	278, 321, 101, 313, 263, 271, 73, 269, 106, 99,
-	0, 296, 100, 105, 88, 113, 102, 119, 125, 126,
-	111, 124, 66, 117, 110, 92, 82, 83, 65, 0,
-	104, 76, 80, 75, 97, 114, 115, 74, 132, 69,
-	123, 68, 70, 122, 96, 112, 118, 93, 90, 67,
-	116, 91, 89, 84, 78, 0, 246, 0, 109, 120,
-	133, 259, 316, 127, 128, 129, 130, 95, 71, 81,
-	107, 257, 258, 255, 256, 292, 293, 325, 326, 327,
-	308, 252, 0, 0, 311, 295, 64, 0, 86, 131,
-	103, 79, 121, 320, 310, 283, 322, 261, 275, 330,
-	276, 277, 304, 249, 291, 98, 273, 0, 264, 244,
-	270, 245, 262, 285, 77, 288, 260, 312, 294, 85,
-	328, 87, 299, 0, 108, 94, 0, 0, 287, 314,
-	289, 309, 282, 305, 254, 298, 323, 274, 302, 324,
-	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 301, 319, 272, 303, 243, 300,
-	0, 247, 250, 329, 317, 267, 268, 0, 0, 0,
-	0, 0, 0, 0, 286, 290, 306, 280, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 297, 0,
-	0, 0, 251, 248, 0, 284, 0, 0, 0, 253,
-	0, 266, 307, 0, 315, 281, 146, 318, 279, 278,
-	321, 101, 313, 263, 271, 73, 269, 106, 99, 0,
-	296, 100, 105, 88, 113, 102, 119, 125, 126, 111,
-	124, 66, 117, 110, 92, 82, 83, 65, 0, 104,
-	76, 80, 75, 97, 114, 115, 74, 132, 69, 123,
-	68, 70, 122, 96, 112, 118, 93, 90, 67, 116,
-	91, 89, 84, 78, 0, 246, 0, 109, 120, 133,
-	259, 316, 127, 128, 129, 130, 95, 71, 81, 107,
-	257, 258, 255, 256, 292, 293, 325, 326, 327, 308,
-	252, 0, 0, 311, 295, 64, 0, 86, 131, 103,
-	79, 121, 320, 310, 283, 322, 261, 275, 330, 276,
-	277, 304, 249, 291, 98, 273, 0, 264, 244, 270,
-	245, 262, 285, 77, 288, 260, 312, 294, 85, 328,
-	87, 299, 0, 108, 94, 0, 0, 287, 314, 289,
-	309, 282, 305, 254, 298, 323, 274, 302, 324, 0,
-	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
-	0, 72, 0, 301, 319, 272, 303, 243, 300, 0,
-	247, 250, 329, 317, 267, 268, 0, 0, 0, 0,
-	0, 0, 0, 286, 290, 306, 280, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 297, 0, 0,
-	0, 251, 248, 0, 284, 0, 0, 0, 253, 0,
-	266, 307, 0, 315, 281, 146, 318, 279, 278, 321,
-	101, 313, 263, 271, 73, 269, 106, 99, 0, 296,
-	100, 105, 88, 113, 102, 119, 125, 126, 111, 124,
-	66, 117, 110, 92, 82, 83, 65, 0, 104, 76,
-	80, 75, 97, 114, 115, 74, 132, 69, 123, 68,
-	70, 122, 96, 112, 118, 93, 90, 67, 116, 91,
-	89, 84, 78, 0, 246, 0, 109, 120, 133, 259,
-	316, 127, 128, 129, 130, 95, 71, 81, 107, 257,
-	258, 255, 256, 292, 293, 325, 326, 327, 308, 252,
-	0, 0, 311, 295, 64, 0, 86, 131, 103, 79,
-	121, 98, 0, 0, 744, 0, 350, 0, 0, 0,
-	77, 0, 349, 0, 0, 85, 388, 87, 0, 0,
-	108, 94, 0, 0, 0, 0, 381, 382, 0, 0,
-	0, 0, 0, 0, 0, 0, 41, 0, 0, 210,
-	368, 367, 370, 371, 372, 373, 0, 0, 72, 369,
-	374, 375, 376, 0, 0, 347, 361, 0, 387, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
+	0, 0, 0, 0, 407, 0, 368, 0, 0, 364,
+	365, 370, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 151, 0, 0, 405, 0, 104, 0, 0,
+	0, 76, 0, 109, 102, 0, 0, 103, 108, 91,
+	117, 105, 123, 115, 129, 130, 114, 128, 69, 121,
+	113, 95, 85, 86, 68, 0, 107, 79, 83, 78,
+	100, 118, 119, 77, 136, 72, 127, 71, 73, 126,
+	99, 116, 122, 96, 93, 70, 120, 94, 92, 87,
+	81, 0, 0, 0, 112, 124, 137, 0, 0, 131,
+	132, 133, 134, 98, 74, 84, 110, 397, 406, 403,
+	404, 401, 402, 400, 399, 398, 408, 391, 392, 394,
+	0, 393, 67, 0, 89, 135, 106, 82, 125, 101,
+	0, 0, 0, 0, 358, 0, 0, 0, 80, 0,
+	357, 0, 0, 88, 396, 90, 0, 0, 111, 97,
+	0, 0, 0, 0, 389, 390, 0, 0, 0, 0,
+	0, 0, 0, 0, 41, 0, 0, 215, 376, 375,
+	378, 379, 380, 381, 0, 0, 75, 377, 382, 383,
+	384, 0, 0, 355, 369, 0, 395, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 358, 359,
-	747, 0, 0, 0, 399, 0, 360, 0, 0, 356,
-	357, 362, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 397, 0, 101, 0, 0,
-	0, 73, 0, 106, 99, 0, 0, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	0, 0, 0, 109, 120, 133, 0, 0, 127, 128,
-	129, 130, 95, 71, 81, 107, 389, 398, 395, 396,
-	393, 394, 392, 391, 390, 400, 383, 384, 386, 0,
-	385, 64, 0, 86, 131, 103, 79, 121, 98, 0,
-	0, 0, 0, 350, 0, 0, 0, 77, 0, 349,
-	0, 0, 85, 388, 87, 0, 0, 108, 94, 0,
-	0, 0, 0, 381, 382, 0, 0, 0, 0, 0,
-	0, 0, 0, 41, 0, 341, 210, 368, 367, 370,
-	371, 372, 373, 0, 0, 72, 369, 374, 375, 376,
-	0, 0, 347, 361, 0, 387, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 366, 367, 760, 0,
+	0, 0, 407, 0, 368, 0, 0, 364, 365, 370,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 358, 359, 0, 0, 0,
-	0, 399, 0, 360, 0, 0, 356, 357, 362, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 146,
-	0, 0, 397, 0, 101, 0, 0, 0, 73, 0,
-	106, 99, 0, 0, 100, 105, 88, 113, 102, 119,
-	125, 126, 111, 124, 66, 117, 110, 92, 82, 83,
-	65, 0, 104, 76, 80, 75, 97, 114, 115, 74,
-	132, 69, 123, 68, 70, 122, 96, 112, 118, 93,
-	90, 67, 116, 91, 89, 84, 78, 0, 0, 0,
-	109, 120, 133, 0, 0, 127, 128, 129, 130, 95,
-	71, 81, 107, 389, 398, 395, 396, 393, 394, 392,
-	391, 390, 400, 383, 384, 386, 0, 385, 64, 0,
-	86, 131, 103, 79, 121, 98, 0, 0, 0, 0,
-	350, 0, 0, 0, 77, 0, 349, 0, 0, 85,
-	388, 87, 0, 0, 108, 94, 0, 0, 0, 0,
-	381, 382, 0, 0, 0, 0, 0, 0, 0, 0,
-	41, 0, 0, 210, 368, 367, 370, 371, 372, 373,
-	0, 0, 72, 369, 374, 375, 376, 0, 0, 347,
-	361, 0, 387, 0, 0, 0, 0, 0, 0, 0,
+	151, 0, 0, 405, 0, 104, 0, 0, 0, 76,
+	0, 109, 102, 0, 0, 103, 108, 91, 117, 105,
+	123, 115, 129, 130, 114, 128, 69, 121, 113, 95,
+	85, 86, 68, 0, 107, 79, 83, 78, 100, 118,
+	119, 77, 136, 72, 127, 71, 73, 126, 99, 116,
+	122, 96, 93, 70, 120, 94, 92, 87, 81, 0,
+	0, 0, 112, 124, 137, 0, 0, 131, 132, 133,
+	134, 98, 74, 84, 110, 397, 406, 403, 404, 401,
+	402, 400, 399, 398, 408, 391, 392, 394, 19, 393,
+	67, 0, 89, 135, 106, 82, 125, 0, 0, 101,
+	0, 0, 0, 0, 358, 0, 0, 0, 80, 0,
+	357, 0, 0, 88, 396, 90, 0, 0, 111, 97,
+	0, 0, 0, 0, 389, 390, 0, 0, 0, 0,
+	0, 0, 0, 0, 41, 0, 0, 215, 376, 375,
+	378, 379, 380, 381, 0, 0, 75, 377, 382, 383,
+	384, 0, 0, 355, 369, 0, 395, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 358, 359, 747, 0, 0, 0, 399, 0,
-	360, 0, 0, 356, 357, 362, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 146, 0, 0, 397,
-	0, 101, 0, 0, 0, 73, 0, 106, 99, 0,
-	0, 100, 105, 88, 113, 102, 119, 125, 126, 111,
-	124, 66, 117, 110, 92, 82, 83, 65, 0, 104,
-	76, 80, 75, 97, 114, 115, 74, 132, 69, 123,
-	68, 70, 122, 96, 112, 118, 93, 90, 67, 116,
-	91, 89, 84, 78, 0, 0, 0, 109, 120, 133,
-	0, 0, 127, 128, 129, 130, 95, 71, 81, 107,
-	389, 398, 395, 396, 393, 394, 392, 391, 390, 400,
-	383, 384, 386, 19, 385, 64, 0, 86, 131, 103,
-	79, 121, 0, 0, 98, 0, 0, 0, 0, 350,
-	0, 0, 0, 77, 0, 349, 0, 0, 85, 388,
-	87, 0, 0, 108, 94, 0, 0, 0, 0, 381,
-	382, 0, 0, 0, 0, 0, 0, 0, 0, 41,
-	0, 0, 210, 368, 367, 370, 371, 372, 373, 0,
-	0, 72, 369, 374, 375, 376, 0, 0, 347, 361,
-	0, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 366, 367, 0, 0,
+	0, 0, 407, 0, 368, 0, 0, 364, 365, 370,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 358, 359, 0, 0, 0, 0, 399, 0, 360,
-	0, 0, 356, 357, 362, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 146, 0, 0, 397, 0,
-	101, 0, 0, 0, 73, 0, 106, 99, 0, 0,
-	100, 105, 88, 113, 102, 119, 125, 126, 111, 124,
-	66, 117, 110, 92, 82, 83, 65, 0, 104, 76,
-	80, 75, 97, 114, 115, 74, 132, 69, 123, 68,
-	70, 122, 96, 112, 118, 93, 90, 67, 116, 91,
-	89, 84, 78, 0, 0, 0, 109, 120, 133, 0,
-	0, 127, 128, 129, 130, 95, 71, 81, 107, 389,
-	398, 395, 396, 393, 394, 392, 391, 390, 400, 383,
-	384, 386, 0, 385, 64, 0, 86, 131, 103, 79,
-	121, 98, 0, 0, 0, 0, 350, 0, 0, 0,
-	77, 0, 349, 0, 0, 85, 388, 87, 0, 0,
-	108, 94, 0, 0, 0, 0, 381, 382, 0, 0,
-	0, 0, 0, 0, 0, 0, 41, 0, 0, 210,
-	368, 367, 370, 371, 372, 373, 0, 0, 72, 369,
-	374, 375, 376, 0, 0, 347, 361, 0, 387, 0,
+	151, 0, 0, 405, 0, 104, 0, 0, 0, 76,
+	0, 109, 102, 0, 0, 103, 108, 91, 117, 105,
+	123, 115, 129, 130, 114, 128, 69, 121, 113, 95,
+	85, 86, 68, 0, 107, 79, 83, 78, 100, 118,
+	119, 77, 136, 72, 127, 71, 73, 126, 99, 116,
+	122, 96, 93, 70, 120, 94, 92, 87, 81, 0,
+	0, 0, 112, 124, 137, 0, 0, 131, 132, 133,
+	134, 98, 74, 84, 110, 397, 406, 403, 404, 401,
+	402, 400, 399, 398, 408, 391, 392, 394, 0, 393,
+	67, 0, 89, 135, 106, 82, 125, 101, 0, 0,
+	0, 0, 358, 0, 0, 0, 80, 0, 357, 0,
+	0, 88, 396, 90, 0, 0, 111, 97, 0, 0,
+	0, 0, 389, 390, 0, 0, 0, 0, 0, 0,
+	0, 0, 41, 0, 0, 215, 376, 375, 378, 379,
+	380, 381, 0, 0, 75, 377, 382, 383, 384, 0,
+	0, 355, 369, 0, 395, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 358, 359,
-	0, 0, 0, 0, 399, 0, 360, 0, 0, 356,
-	357, 362, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 397, 0, 101, 0, 0,
-	0, 73, 0, 106, 99, 0, 0, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	0, 0, 0, 109, 120, 133, 0, 0, 127, 128,
-	129, 130, 95, 71, 81, 107, 389, 398, 395, 396,
-	393, 394, 392, 391, 390, 400, 383, 384, 386, 98,
-	385, 64, 0, 86, 131, 103, 79, 121, 77, 0,
-	0, 0, 0, 85, 388, 87, 0, 0, 108, 94,
-	0, 0, 0, 0, 381, 382, 0, 0, 0, 0,
-	0, 0, 0, 0, 41, 0, 0, 210, 368, 367,
-	370, 371, 372, 373, 0, 0, 72, 369, 374, 375,
-	376, 0, 0, 0, 361, 0, 387, 0, 0, 0,
+	0, 0, 0, 0, 366, 367, 0, 0, 0, 0,
+	407, 0, 368, 0, 0, 364, 365, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 0,
+	0, 405, 0, 104, 0, 0, 0, 76, 0, 109,
+	102, 0, 0, 103, 108, 91, 117, 105, 123, 115,
+	129, 130, 114, 128, 69, 121, 113, 95, 85, 86,
+	68, 0, 107, 79, 83, 78, 100, 118, 119, 77,
+	136, 72, 127, 71, 73, 126, 99, 116, 122, 96,
+	93, 70, 120, 94, 92, 87, 81, 0, 0, 0,
+	112, 124, 137, 0, 0, 131, 132, 133, 134, 98,
+	74, 84, 110, 397, 406, 403, 404, 401, 402, 400,
+	399, 398, 408, 391, 392, 394, 101, 393, 67, 0,
+	89, 135, 106, 82, 125, 80, 0, 0, 0, 0,
+	88, 396, 90, 0, 0, 111, 97, 0, 0, 0,
+	0, 389, 390, 0, 0, 0, 0, 0, 0, 0,
+	0, 41, 0, 0, 215, 376, 375, 378, 379, 380,
+	381, 0, 0, 75, 377, 382, 383, 384, 0, 0,
+	0, 369, 0, 395, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 358, 359, 0, 0,
-	0, 0, 399, 0, 360, 0, 0, 356, 357, 362,
+	0, 0, 0, 366, 367, 0, 0, 0, 0, 407,
+	0, 368, 0, 0, 364, 365, 370, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
+	405, 0, 104, 0, 0, 0, 76, 0, 109, 102,
+	0, 1096, 103, 108, 91, 117, 105, 123, 115, 129,
+	130, 114, 128, 69, 121, 113, 95, 85, 86, 68,
+	0, 107, 79, 83, 78, 100, 118, 119, 77, 136,
+	72, 127, 71, 73, 126, 99, 116, 122, 96, 93,
+	70, 120, 94, 92, 87, 81, 0, 0, 0, 112,
+	124, 137, 0, 0, 131, 132, 133, 134, 98, 74,
+	84, 110, 397, 406, 403, 404, 401, 402, 400, 399,
+	398, 408, 391, 392, 394, 101, 393, 67, 0, 89,
+	135, 106, 82, 125, 80, 0, 0, 0, 0, 88,
+	396, 90, 0, 0, 111, 97, 0, 0, 0, 0,
+	389, 390, 0, 0, 0, 0, 0, 0, 0, 0,
+	41, 0, 0, 215, 376, 375, 378, 379, 380, 381,
+	0, 0, 75, 377, 382, 383, 384, 0, 0, 0,
+	369, 0, 395, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	146, 0, 0, 397, 0, 101, 0, 0, 0, 73,
-	0, 106, 99, 0, 1083, 100, 105, 88, 113, 102,
-	119, 125, 126, 111, 124, 66, 117, 110, 92, 82,
-	83, 65, 0, 104, 76, 80, 75, 97, 114, 115,
-	74, 132, 69, 123, 68, 70, 122, 96, 112, 118,
-	93, 90, 67, 116, 91, 89, 84, 78, 0, 0,
-	0, 109, 120, 133, 0, 0, 127, 128, 129, 130,
-	95, 71, 81, 107, 389, 398, 395, 396, 393, 394,
-	392, 391, 390, 400, 383, 384, 386, 98, 385, 64,
-	0, 86, 131, 103, 79, 121, 77, 0, 0, 0,
-	0, 85, 388, 87, 0, 0, 108, 94, 0, 0,
-	0, 0, 381, 382, 0, 0, 0, 0, 0, 0,
-	0, 0, 41, 0, 0, 210, 368, 367, 370, 371,
-	372, 373, 0, 0, 72, 369, 374, 375, 376, 0,
-	0, 0, 361, 0, 387, 0, 0, 0, 0, 0,
+	0, 0, 366, 367, 0, 0, 0, 0, 407, 0,
+	368, 0, 0, 364, 365, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 151, 0, 0, 405,
+	0, 104, 0, 0, 0, 76, 0, 109, 102, 0,
+	0, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 0, 0, 112, 124,
+	137, 0, 0, 131, 132, 133, 134, 98, 74, 84,
+	110, 397, 406, 403, 404, 401, 402, 400, 399, 398,
+	408, 391, 392, 394, 0, 393, 67, 0, 89, 135,
+	106, 82, 125, 101, 0, 0, 0, 870, 0, 0,
+	0, 0, 80, 0, 0, 0, 0, 88, 0, 90,
+	0, 0, 111, 97, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 358, 359, 0, 0, 0, 0,
-	399, 0, 360, 0, 0, 356, 357, 362, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 397, 0, 101, 0, 0, 0, 73, 0, 106,
-	99, 0, 0, 100, 105, 88, 113, 102, 119, 125,
-	126, 111, 124, 66, 117, 110, 92, 82, 83, 65,
-	0, 104, 76, 80, 75, 97, 114, 115, 74, 132,
-	69, 123, 68, 70, 122, 96, 112, 118, 93, 90,
-	67, 116, 91, 89, 84, 78, 0, 0, 0, 109,
-	120, 133, 0, 0, 127, 128, 129, 130, 95, 71,
-	81, 107, 389, 398, 395, 396, 393, 394, 392, 391,
-	390, 400, 383, 384, 386, 98, 385, 64, 0, 86,
-	131, 103, 79, 121, 77, 0, 0, 0, 0, 85,
-	0, 87, 0, 0, 108, 94, 0, 0, 0, 0,
+	0, 65, 0, 872, 0, 0, 0, 0, 0, 0,
+	75, 0, 0, 0, 0, 529, 528, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 62, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 530, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 531, 530,
-	540, 541, 533, 534, 535, 536, 537, 538, 539, 532,
-	0, 0, 542, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 146, 0, 0, 0,
-	0, 101, 0, 0, 0, 73, 0, 106, 99, 0,
-	0, 100, 105, 88, 113, 102, 119, 125, 126, 111,
-	124, 66, 117, 110, 92, 82, 83, 65, 0, 104,
-	76, 80, 75, 97, 114, 115, 74, 132, 69, 123,
-	68, 70, 122, 96, 112, 118, 93, 90, 67, 116,
-	91, 89, 84, 78, 0, 0, 0, 109, 120, 133,
-	0, 0, 127, 128, 129, 130, 95, 71, 81, 107,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 64, 0, 86, 131, 103,
-	79, 121, 98, 0, 0, 0, 857, 0, 0, 0,
-	0, 77, 0, 0, 0, 0, 85, 0, 87, 0,
-	0, 108, 94, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 104,
+	0, 0, 0, 76, 0, 109, 102, 0, 0, 103,
+	108, 91, 117, 105, 123, 115, 129, 130, 114, 128,
+	69, 121, 113, 95, 85, 86, 68, 0, 107, 79,
+	83, 78, 100, 118, 119, 77, 136, 72, 127, 71,
+	73, 126, 99, 116, 122, 96, 93, 70, 120, 94,
+	92, 87, 81, 0, 0, 0, 112, 124, 137, 0,
+	101, 131, 132, 133, 134, 98, 74, 84, 110, 80,
+	0, 0, 0, 0, 88, 0, 90, 0, 0, 111,
+	97, 0, 0, 0, 67, 0, 89, 135, 106, 82,
+	125, 0, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 0, 0,
+	0, 0, 61, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	62, 0, 859, 0, 0, 0, 0, 0, 0, 72,
-	0, 0, 0, 0, 519, 518, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 520, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 62,
+	0, 60, 0, 0, 0, 63, 104, 0, 0, 0,
+	76, 0, 109, 102, 0, 0, 103, 108, 91, 117,
+	105, 123, 115, 129, 130, 114, 128, 69, 121, 113,
+	95, 85, 86, 68, 0, 107, 79, 83, 78, 100,
+	118, 119, 77, 136, 72, 127, 71, 73, 126, 99,
+	116, 122, 96, 93, 70, 120, 94, 92, 87, 81,
+	0, 0, 0, 112, 124, 137, 0, 0, 131, 132,
+	133, 134, 98, 74, 84, 110, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 146, 0, 0, 0, 0, 101, 0,
-	0, 0, 73, 0, 106, 99, 0, 0, 100, 105,
-	88, 113, 102, 119, 125, 126, 111, 124, 66, 117,
-	110, 92, 82, 83, 65, 0, 104, 76, 80, 75,
-	97, 114, 115, 74, 132, 69, 123, 68, 70, 122,
-	96, 112, 118, 93, 90, 67, 116, 91, 89, 84,
-	78, 0, 0, 0, 109, 120, 133, 0, 98, 127,
-	128, 129, 130, 95, 71, 81, 107, 77, 0, 0,
-	0, 0, 85, 0, 87, 0, 0, 108, 94, 0,
-	0, 0, 64, 0, 86, 131, 103, 79, 121, 0,
-	0, 0, 0, 0, 0, 0, 62, 0, 0, 0,
-	0, 0, 0, 0, 0, 72, 0, 0, 0, 0,
-	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 67, 0, 89, 135, 106, 82, 125, 101, 0,
+	0, 0, 443, 0, 0, 0, 0, 80, 0, 0,
+	0, 0, 88, 0, 90, 0, 0, 111, 97, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 0, 445, 0,
+	0, 0, 0, 0, 0, 75, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 59, 0, 57,
-	0, 0, 0, 60, 101, 0, 0, 0, 73, 0,
-	106, 99, 0, 0, 100, 105, 88, 113, 102, 119,
-	125, 126, 111, 124, 66, 117, 110, 92, 82, 83,
-	65, 0, 104, 76, 80, 75, 97, 114, 115, 74,
-	132, 69, 123, 68, 70, 122, 96, 112, 118, 93,
-	90, 67, 116, 91, 89, 84, 78, 0, 0, 0,
-	109, 120, 133, 0, 0, 127, 128, 129, 130, 95,
-	71, 81, 107, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 64, 0,
-	86, 131, 103, 79, 121, 98, 0, 0, 0, 435,
-	0, 0, 0, 0, 77, 0, 0, 0, 0, 85,
-	0, 87, 0, 0, 108, 94, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 144, 0, 437, 0, 0, 0, 0,
-	0, 0, 72, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
+	0, 0, 0, 0, 104, 0, 0, 0, 76, 0,
+	109, 102, 0, 0, 103, 108, 91, 117, 105, 123,
+	115, 129, 130, 114, 128, 69, 121, 113, 95, 85,
+	86, 68, 0, 107, 79, 83, 78, 100, 118, 119,
+	77, 136, 72, 127, 71, 73, 126, 99, 116, 122,
+	96, 93, 70, 120, 94, 92, 87, 81, 0, 0,
+	0, 112, 124, 137, 0, 0, 131, 132, 133, 134,
+	98, 74, 84, 110, 0, 0, 19, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 67,
+	0, 89, 135, 106, 82, 125, 80, 0, 0, 0,
+	0, 88, 0, 90, 0, 0, 111, 97, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 41, 0, 0, 65, 0, 0, 0, 0,
+	0, 0, 0, 0, 75, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 146, 0, 0, 0,
-	0, 101, 0, 0, 0, 73, 0, 106, 99, 0,
-	0, 100, 105, 88, 113, 102, 119, 125, 126, 111,
-	124, 66, 117, 110, 92, 82, 83, 65, 0, 104,
-	76, 80, 75, 97, 114, 115, 74, 132, 69, 123,
-	68, 70, 122, 96, 112, 118, 93, 90, 67, 116,
-	91, 89, 84, 78, 0, 0, 0, 109, 120, 133,
-	0, 0, 127, 128, 129, 130, 95, 71, 81, 107,
-	0, 0, 19, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 64, 0, 86, 131, 103,
-	79, 121, 77, 0, 0, 0, 0, 85, 0, 87,
-	0, 0, 108, 94, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 41, 0,
-	0, 62, 0, 0, 0, 0, 0, 0, 0, 0,
-	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 0,
+	0, 0, 0, 104, 0, 0, 0, 76, 0, 109,
+	102, 0, 0, 103, 108, 91, 117, 105, 123, 115,
+	129, 130, 114, 128, 69, 121, 113, 95, 85, 86,
+	68, 0, 107, 79, 83, 78, 100, 118, 119, 77,
+	136, 72, 127, 71, 73, 126, 99, 116, 122, 96,
+	93, 70, 120, 94, 92, 87, 81, 0, 0, 0,
+	112, 124, 137, 0, 0, 131, 132, 133, 134, 98,
+	74, 84, 110, 0, 0, 19, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 67, 0,
+	89, 135, 106, 82, 125, 80, 0, 0, 0, 0,
+	88, 0, 90, 0, 0, 111, 97, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 41, 0, 0, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 75, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 146, 0, 0, 0, 0, 101,
-	0, 0, 0, 73, 0, 106, 99, 0, 0, 100,
-	105, 88, 113, 102, 119, 125, 126, 111, 124, 66,
-	117, 110, 92, 82, 83, 65, 0, 104, 76, 80,
-	75, 97, 114, 115, 74, 132, 69, 123, 68, 70,
-	122, 96, 112, 118, 93, 90, 67, 116, 91, 89,
-	84, 78, 0, 0, 0, 109, 120, 133, 0, 0,
-	127, 128, 129, 130, 95, 71, 81, 107, 0, 0,
-	19, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 64, 0, 86, 131, 103, 79, 121,
-	77, 0, 0, 0, 0, 85, 0, 87, 0, 0,
-	108, 94, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 41, 0, 0, 144,
-	0, 0, 0, 0, 0, 0, 0, 0, 72, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
+	0, 0, 104, 0, 0, 0, 76, 0, 109, 102,
+	0, 0, 103, 108, 91, 117, 105, 123, 115, 129,
+	130, 114, 128, 69, 121, 113, 95, 85, 86, 68,
+	0, 107, 79, 83, 78, 100, 118, 119, 77, 136,
+	72, 127, 71, 73, 126, 99, 116, 122, 96, 93,
+	70, 120, 94, 92, 87, 81, 0, 0, 0, 112,
+	124, 137, 0, 101, 131, 132, 133, 134, 98, 74,
+	84, 110, 80, 0, 0, 0, 0, 88, 0, 90,
+	0, 0, 111, 97, 0, 0, 0, 67, 0, 89,
+	135, 106, 82, 125, 0, 0, 0, 0, 0, 0,
+	0, 65, 0, 0, 609, 0, 0, 610, 0, 0,
+	75, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 0, 0, 101, 0, 0,
-	0, 73, 0, 106, 99, 0, 0, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	0, 0, 0, 109, 120, 133, 0, 98, 127, 128,
-	129, 130, 95, 71, 81, 107, 77, 0, 0, 0,
-	0, 85, 0, 87, 0, 0, 108, 94, 0, 0,
-	0, 64, 0, 86, 131, 103, 79, 121, 0, 0,
-	0, 0, 0, 0, 0, 62, 0, 0, 599, 0,
-	0, 600, 0, 0, 72, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 104,
+	0, 0, 0, 76, 0, 109, 102, 0, 0, 103,
+	108, 91, 117, 105, 123, 115, 129, 130, 114, 128,
+	69, 121, 113, 95, 85, 86, 68, 0, 107, 79,
+	83, 78, 100, 118, 119, 77, 136, 72, 127, 71,
+	73, 126, 99, 116, 122, 96, 93, 70, 120, 94,
+	92, 87, 81, 0, 0, 0, 112, 124, 137, 0,
+	0, 131, 132, 133, 134, 98, 74, 84, 110, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 67, 0, 89, 135, 106, 82,
+	125, 101, 0, 0, 0, 443, 0, 0, 0, 0,
+	80, 0, 0, 0, 0, 88, 0, 90, 0, 0,
+	111, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
+	0, 445, 0, 0, 0, 0, 0, 0, 75, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 0, 101, 0, 0, 0, 73, 0, 106,
-	99, 0, 0, 100, 105, 88, 113, 102, 119, 125,
-	126, 111, 124, 66, 117, 110, 92, 82, 83, 65,
-	0, 104, 76, 80, 75, 97, 114, 115, 74, 132,
-	69, 123, 68, 70, 122, 96, 112, 118, 93, 90,
-	67, 116, 91, 89, 84, 78, 0, 0, 0, 109,
-	120, 133, 0, 0, 127, 128, 129, 130, 95, 71,
-	81, 107, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 86,
-	131, 103, 79, 121, 98, 0, 0, 0, 435, 0,
-	0, 0, 0, 77, 0, 0, 0, 0, 85, 0,
-	87, 0, 0, 108, 94, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 144, 0, 437, 0, 0, 0, 0, 0,
-	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 151, 0, 0, 0, 0, 104, 0, 0,
+	0, 76, 0, 109, 102, 0, 0, 441, 108, 91,
+	117, 105, 123, 115, 129, 130, 114, 128, 69, 121,
+	113, 95, 85, 86, 68, 0, 107, 79, 83, 78,
+	100, 118, 119, 77, 136, 72, 127, 71, 73, 126,
+	99, 116, 122, 96, 93, 70, 120, 94, 92, 87,
+	81, 0, 0, 0, 112, 124, 137, 0, 101, 131,
+	132, 133, 134, 98, 74, 84, 110, 80, 0, 0,
+	0, 0, 88, 0, 90, 0, 0, 111, 97, 0,
+	0, 0, 67, 0, 89, 135, 106, 82, 125, 0,
+	0, 0, 0, 41, 0, 0, 149, 0, 0, 0,
+	0, 0, 0, 0, 0, 75, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 146, 0, 0, 0, 0,
-	101, 0, 0, 0, 73, 0, 106, 99, 0, 0,
-	433, 105, 88, 113, 102, 119, 125, 126, 111, 124,
-	66, 117, 110, 92, 82, 83, 65, 0, 104, 76,
-	80, 75, 97, 114, 115, 74, 132, 69, 123, 68,
-	70, 122, 96, 112, 118, 93, 90, 67, 116, 91,
-	89, 84, 78, 0, 0, 0, 109, 120, 133, 0,
-	98, 127, 128, 129, 130, 95, 71, 81, 107, 77,
-	0, 0, 0, 0, 85, 0, 87, 0, 0, 108,
-	94, 0, 0, 0, 64, 0, 86, 131, 103, 79,
-	121, 0, 0, 0, 0, 41, 0, 0, 144, 0,
-	0, 0, 0, 0, 0, 0, 0, 72, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
+	0, 0, 0, 0, 104, 0, 0, 0, 76, 0,
+	109, 102, 0, 0, 103, 108, 91, 117, 105, 123,
+	115, 129, 130, 114, 128, 69, 121, 113, 95, 85,
+	86, 68, 0, 107, 79, 83, 78, 100, 118, 119,
+	77, 136, 72, 127, 71, 73, 126, 99, 116, 122,
+	96, 93, 70, 120, 94, 92, 87, 81, 0, 0,
+	0, 112, 124, 137, 0, 101, 131, 132, 133, 134,
+	98, 74, 84, 110, 80, 0, 0, 0, 0, 88,
+	0, 90, 0, 0, 111, 97, 0, 0, 0, 67,
+	0, 89, 135, 106, 82, 125, 0, 0, 0, 0,
+	0, 0, 0, 65, 0, 872, 0, 0, 0, 0,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 146, 0, 0, 0, 0, 101, 0, 0, 0,
-	73, 0, 106, 99, 0, 0, 100, 105, 88, 113,
-	102, 119, 125, 126, 111, 124, 66, 117, 110, 92,
-	82, 83, 65, 0, 104, 76, 80, 75, 97, 114,
-	115, 74, 132, 69, 123, 68, 70, 122, 96, 112,
-	118, 93, 90, 67, 116, 91, 89, 84, 78, 0,
-	0, 0, 109, 120, 133, 0, 98, 127, 128, 129,
-	130, 95, 71, 81, 107, 77, 0, 0, 0, 0,
-	85, 0, 87, 0, 0, 108, 94, 0, 0, 0,
-	64, 0, 86, 131, 103, 79, 121, 0, 0, 0,
-	0, 0, 0, 0, 62, 0, 859, 0, 0, 0,
-	0, 0, 0, 72, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 151, 0, 0, 0,
+	0, 104, 0, 0, 0, 76, 0, 109, 102, 0,
+	0, 103, 108, 91, 117, 105, 123, 115, 129, 130,
+	114, 128, 69, 121, 113, 95, 85, 86, 68, 0,
+	107, 79, 83, 78, 100, 118, 119, 77, 136, 72,
+	127, 71, 73, 126, 99, 116, 122, 96, 93, 70,
+	120, 94, 92, 87, 81, 0, 0, 0, 112, 124,
+	137, 0, 101, 131, 132, 133, 134, 98, 74, 84,
+	110, 80, 0, 0, 0, 0, 88, 0, 90, 0,
+	0, 111, 97, 0, 0, 0, 67, 0, 89, 135,
+	106, 82, 125, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 445, 0, 0, 0, 0, 0, 0, 75,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 146, 0, 0,
-	0, 0, 101, 0, 0, 0, 73, 0, 106, 99,
-	0, 0, 100, 105, 88, 113, 102, 119, 125, 126,
-	111, 124, 66, 117, 110, 92, 82, 83, 65, 0,
-	104, 76, 80, 75, 97, 114, 115, 74, 132, 69,
-	123, 68, 70, 122, 96, 112, 118, 93, 90, 67,
-	116, 91, 89, 84, 78, 0, 0, 0, 109, 120,
-	133, 0, 98, 127, 128, 129, 130, 95, 71, 81,
-	107, 77, 0, 0, 0, 0, 85, 0, 87, 0,
-	0, 108, 94, 0, 0, 0, 64, 0, 86, 131,
-	103, 79, 121, 0, 0, 0, 0, 0, 0, 0,
-	144, 0, 437, 0, 0, 0, 0, 0, 0, 72,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 151, 0, 0, 0, 0, 104, 0,
+	0, 0, 76, 0, 109, 102, 0, 0, 103, 108,
+	91, 117, 105, 123, 115, 129, 130, 114, 128, 69,
+	121, 113, 95, 85, 86, 68, 0, 107, 79, 83,
+	78, 100, 118, 119, 77, 136, 72, 127, 71, 73,
+	126, 99, 116, 122, 96, 93, 70, 120, 94, 92,
+	87, 81, 0, 0, 0, 112, 124, 137, 0, 0,
+	131, 132, 133, 134, 98, 74, 84, 110, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 67, 0, 89, 135, 106, 82, 125,
+	420, 80, 0, 0, 0, 0, 88, 0, 90, 0,
+	0, 111, 97, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 146, 0, 0, 0, 0, 101, 0,
-	0, 0, 73, 0, 106, 99, 0, 0, 100, 105,
-	88, 113, 102, 119, 125, 126, 111, 124, 66, 117,
-	110, 92, 82, 83, 65, 0, 104, 76, 80, 75,
-	97, 114, 115, 74, 132, 69, 123, 68, 70, 122,
-	96, 112, 118, 93, 90, 67, 116, 91, 89, 84,
-	78, 0, 0, 0, 109, 120, 133, 0, 0, 127,
-	128, 129, 130, 95, 71, 81, 107, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 0, 75,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 64, 0, 86, 131, 103, 79, 121, 412,
-	77, 0, 0, 0, 0, 85, 0, 87, 0, 0,
-	108, 94, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
-	0, 0, 0, 0, 0, 0, 0, 0, 72, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 151, 0, 0, 0, 0, 104, 0,
+	0, 0, 76, 0, 109, 102, 0, 0, 103, 108,
+	91, 117, 105, 123, 115, 129, 130, 114, 128, 69,
+	121, 113, 95, 85, 86, 68, 0, 107, 79, 83,
+	78, 100, 118, 119, 77, 136, 72, 127, 71, 73,
+	126, 99, 116, 122, 96, 93, 70, 120, 94, 92,
+	87, 81, 200, 0, 0, 112, 124, 137, 0, 101,
+	131, 132, 133, 134, 98, 74, 84, 110, 80, 0,
+	0, 0, 0, 88, 0, 90, 0, 0, 111, 97,
+	0, 0, 0, 67, 0, 89, 135, 106, 82, 125,
+	0, 0, 0, 0, 0, 0, 0, 149, 0, 0,
+	0, 0, 0, 0, 0, 0, 75, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 0, 0, 101, 0, 0,
-	0, 73, 0, 106, 99, 0, 0, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	195, 0, 0, 109, 120, 133, 0, 98, 127, 128,
-	129, 130, 95, 71, 81, 107, 77, 0, 0, 0,
-	0, 85, 0, 87, 0, 0, 108, 94, 0, 0,
-	0, 64, 0, 86, 131, 103, 79, 121, 0, 0,
-	0, 0, 0, 0, 0, 144, 0, 0, 0, 0,
-	0, 0, 0, 0, 72, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 0, 101, 0, 0, 0, 73, 0, 106,
-	99, 0, 0, 100, 105, 88, 113, 102, 119, 125,
-	126, 111, 124, 66, 117, 110, 92, 82, 83, 65,
-	0, 104, 76, 80, 75, 97, 114, 115, 74, 132,
-	69, 123, 68, 70, 122, 96, 112, 118, 93, 90,
-	67, 116, 91, 89, 84, 78, 0, 0, 0, 109,
-	120, 133, 0, 98, 127, 128, 129, 130, 95, 71,
-	81, 107, 77, 0, 0, 0, 0, 85, 0, 87,
-	0, 0, 108, 94, 0, 0, 0, 64, 0, 86,
-	131, 103, 79, 121, 0, 0, 0, 0, 0, 0,
-	0, 144, 0, 0, 0, 0, 0, 0, 0, 0,
-	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	151, 0, 0, 0, 0, 104, 0, 0, 0, 76,
+	0, 109, 102, 0, 0, 103, 108, 91, 117, 105,
+	123, 115, 129, 130, 114, 128, 69, 121, 113, 95,
+	85, 86, 68, 0, 107, 79, 83, 78, 100, 118,
+	119, 77, 136, 72, 127, 71, 73, 126, 99, 116,
+	122, 96, 93, 70, 120, 94, 92, 87, 81, 0,
+	0, 0, 112, 124, 137, 0, 101, 131, 132, 133,
+	134, 98, 74, 84, 110, 80, 0, 0, 0, 0,
+	88, 0, 90, 0, 0, 111, 97, 0, 0, 0,
+	67, 0, 89, 135, 106, 82, 125, 0, 0, 0,
+	0, 0, 0, 0, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 75, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 141, 0, 146, 0, 0, 0, 0, 101,
-	0, 0, 0, 73, 0, 106, 99, 0, 0, 100,
-	105, 88, 113, 102, 119, 125, 126, 111, 124, 66,
-	117, 110, 92, 82, 83, 65, 0, 104, 76, 80,
-	75, 97, 114, 115, 74, 132, 69, 123, 68, 70,
-	122, 96, 112, 118, 93, 90, 67, 116, 91, 89,
-	84, 78, 0, 0, 0, 109, 120, 133, 0, 98,
-	127, 128, 129, 130, 95, 71, 81, 107, 77, 0,
-	0, 0, 0, 85, 0, 87, 0, 0, 108, 94,
-	0, 0, 0, 64, 0, 86, 131, 103, 79, 121,
-	0, 0, 0, 0, 0, 0, 0, 62, 0, 0,
-	0, 0, 0, 0, 0, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 146, 0, 151, 0, 0,
+	0, 0, 104, 0, 0, 0, 76, 0, 109, 102,
+	0, 0, 103, 108, 91, 117, 105, 123, 115, 129,
+	130, 114, 128, 69, 121, 113, 95, 85, 86, 68,
+	0, 107, 79, 83, 78, 100, 118, 119, 77, 136,
+	72, 127, 71, 73, 126, 99, 116, 122, 96, 93,
+	70, 120, 94, 92, 87, 81, 0, 0, 0, 112,
+	124, 137, 0, 101, 131, 132, 133, 134, 98, 74,
+	84, 110, 80, 0, 0, 0, 0, 88, 0, 90,
+	0, 0, 111, 97, 0, 0, 0, 67, 0, 89,
+	135, 106, 82, 125, 0, 0, 0, 0, 0, 0,
+	0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+	75, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 104,
+	0, 0, 0, 76, 0, 109, 102, 0, 0, 103,
+	108, 91, 117, 105, 123, 115, 129, 130, 114, 128,
+	69, 121, 113, 95, 85, 86, 68, 0, 107, 79,
+	83, 78, 100, 118, 119, 77, 136, 72, 127, 71,
+	73, 126, 99, 116, 122, 96, 93, 70, 120, 94,
+	92, 87, 81, 0, 0, 0, 112, 124, 137, 0,
+	101, 131, 132, 133, 134, 98, 74, 84, 110, 80,
+	0, 0, 0, 0, 88, 0, 90, 0, 0, 111,
+	97, 0, 0, 0, 67, 0, 89, 135, 106, 82,
+	125, 0, 0, 0, 0, 0, 0, 0, 215, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	146, 0, 0, 0, 0, 101, 0, 0, 0, 73,
-	0, 106, 99, 0, 0, 100, 105, 88, 113, 102,
-	119, 125, 126, 111, 124, 66, 117, 110, 92, 82,
-	83, 65, 0, 104, 76, 80, 75, 97, 114, 115,
-	74, 132, 69, 123, 68, 70, 122, 96, 112, 118,
-	93, 90, 67, 116, 91, 89, 84, 78, 0, 0,
-	0, 109, 120, 133, 0, 98, 127, 128, 129, 130,
-	95, 71, 81, 107, 77, 0, 0, 0, 0, 85,
-	0, 87, 0, 0, 108, 94, 0, 0, 0, 64,
-	0, 86, 131, 103, 79, 121, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 146, 0, 0, 0,
-	0, 101, 0, 0, 0, 73, 0, 106, 99, 0,
-	0, 100, 105, 88, 113, 102, 119, 125, 126, 111,
-	124, 66, 117, 110, 92, 82, 83, 65, 0, 104,
-	76, 80, 75, 97, 114, 115, 74, 132, 69, 123,
-	68, 70, 122, 96, 112, 118, 93, 90, 67, 116,
-	91, 89, 84, 78, 0, 0, 0, 109, 120, 133,
-	0, 98, 127, 128, 129, 130, 95, 71, 81, 107,
-	77, 0, 0, 0, 0, 85, 0, 87, 0, 0,
-	108, 94, 0, 0, 0, 64, 0, 86, 131, 103,
-	79, 121, 0, 0, 0, 0, 0, 0, 0, 144,
-	0, 0, 0, 0, 0, 0, 0, 0, 72, 0,
+	0, 151, 0, 0, 0, 0, 104, 0, 0, 0,
+	76, 0, 109, 102, 0, 0, 103, 108, 91, 117,
+	105, 123, 115, 129, 130, 114, 128, 69, 121, 113,
+	95, 85, 86, 68, 0, 107, 79, 83, 78, 100,
+	118, 119, 77, 136, 72, 127, 71, 73, 126, 99,
+	116, 122, 96, 93, 70, 120, 94, 92, 87, 81,
+	0, 0, 0, 112, 124, 137, 0, 101, 131, 132,
+	133, 134, 98, 74, 84, 110, 80, 0, 0, 0,
+	0, 88, 0, 90, 0, 0, 111, 97, 0, 0,
+	0, 67, 0, 89, 135, 106, 82, 125, 0, 0,
+	0, 0, 0, 0, 0, 149, 0, 0, 0, 0,
+	0, 0, 0, 0, 75, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 0,
+	0, 0, 0, 104, 0, 0, 0, 76, 0, 109,
+	102, 0, 0, 103, 108, 91, 117, 105, 123, 115,
+	129, 130, 114, 128, 69, 121, 113, 95, 85, 86,
+	68, 0, 107, 79, 83, 78, 100, 118, 119, 77,
+	136, 72, 127, 71, 73, 126, 99, 116, 122, 96,
+	93, 70, 120, 94, 92, 87, 81, 0, 0, 0,
+	112, 124, 137, 0, 101, 131, 132, 133, 134, 98,
+	74, 84, 110, 80, 0, 0, 0, 0, 88, 0,
+	90, 0, 0, 111, 97, 0, 0, 0, 67, 0,
+	89, 135, 106, 82, 125, 0, 0, 0, 0, 0,
+	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
+	0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 146, 0, 0, 0, 0, 101, 0, 0,
-	0, 73, 0, 106, 99, 0, 0, 100, 105, 88,
-	113, 102, 119, 125, 126, 111, 124, 66, 117, 110,
-	92, 82, 83, 65, 0, 104, 76, 80, 75, 97,
-	114, 115, 74, 132, 69, 123, 68, 70, 122, 96,
-	112, 118, 93, 90, 67, 116, 91, 89, 84, 78,
-	0, 0, 0, 109, 120, 133, 0, 98, 127, 128,
-	129, 130, 95, 71, 81, 107, 77, 0, 0, 0,
-	0, 85, 0, 87, 0, 0, 108, 94, 0, 0,
-	0, 64, 0, 86, 131, 103, 79, 121, 0, 0,
-	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	0, 0, 0, 0, 72, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
+	104, 0, 0, 0, 76, 0, 109, 102, 0, 0,
+	103, 108, 91, 117, 105, 123, 115, 129, 130, 114,
+	128, 69, 121, 113, 95, 85, 86, 68, 0, 107,
+	79, 83, 78, 100, 118, 119, 77, 136, 72, 127,
+	71, 210, 126, 99, 116, 122, 96, 93, 70, 120,
+	94, 92, 87, 81, 0, 0, 0, 112, 124, 137,
+	0, 0, 131, 132, 133, 134, 211, 209, 208, 207,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 0, 101, 0, 0, 0, 73, 0, 106,
-	99, 0, 0, 100, 105, 88, 113, 102, 119, 125,
-	126, 111, 124, 66, 117, 110, 92, 82, 83, 65,
-	0, 104, 76, 80, 75, 97, 114, 115, 74, 132,
-	69, 123, 68, 205, 122, 96, 112, 118, 93, 90,
-	67, 116, 91, 89, 84, 78, 0, 0, 0, 109,
-	120, 133, 0, 0, 127, 128, 129, 130, 206, 204,
-	203, 202, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 86,
-	131, 103, 79, 121,
+	0, 0, 0, 0, 0, 67, 0, 89, 135, 106,
+	82, 125,
 }
-var yyPact = [...]int{
 
-	1330, -1000, -156, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 698, 726, -1000,
-	-1000, -1000, -1000, -1000, 503, 5661, 21, 54, 33, 7616,
-	53, 185, 8114, -1000, -1000, -1000, -1000, -1000, 501, -1000,
-	-1000, -1000, -1000, -1000, 693, 696, 512, 679, 591, -1000,
-	23, 6763, 7450, 8280, -1000, 322, 46, 8114, -131, 16,
+var yyPact = [...]int{
+	1329, -1000, -159, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 677, 708, -1000,
+	-1000, -1000, -1000, -1000, 161, 5543, -22, 35, 14, 7509,
+	28, 1315, 8010, -1000, -1000, -1000, -1000, -1000, 490, -1000,
+	-1000, -1000, -1000, -1000, 668, 675, 492, 663, 547, -1000,
+	6, 6651, 7342, 8177, -1000, -1000, 359, 8010, 111, 30,
+	8010, -127, 4, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 17, 8010,
+	8010, -1000, 8010, -3, 341, -3, 8010, -1000, 73, -1000,
+	-1000, -1000, 8010, 333, 597, 27, 2758, 2758, 2758, 2758,
+	-49, 2758, 2758, 509, -1000, -1000, -1000, -1000, 2758, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 272, 618, 4800,
+	4800, 677, -1000, 490, -1000, -1000, -1000, 600, -1000, -1000,
+	204, 7175, 444, 549, -1000, -1000, -1000, 651, 6119, 6484,
+	8010, 408, -1000, 383, 7843, 3178, -1000, -1000, -1000, -1000,
+	593, -1000, 141, -1000, 72, -1000, -1000, 358, -1000, 1407,
+	-1000, 8010, 332, 2758, 16, 8010, 157, 8010, 2758, -1000,
+	18, 8010, 647, 505, 8010, -1000, 3808, -1000, 2758, 2758,
+	2758, 2758, 2758, 2758, 2758, 2758, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29, 8114, -1000, 8114, 13, 306,
-	13, 8114, -1000, 75, -1000, -1000, -1000, 8114, 304, 628,
-	30, 2702, 2702, 2702, 2702, -29, 2702, 2702, 541, -1000,
-	-1000, -1000, -1000, 2702, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 326, 632, 4734, 4734, 698, -1000, 501, -1000,
-	-1000, -1000, 625, -1000, -1000, 182, 7284, 475, 702, -1000,
-	-1000, -1000, 665, 6234, 6597, 8114, 496, -1000, 384, 7948,
-	3120, -1000, -1000, -1000, -1000, 622, -1000, 121, -1000, 74,
-	-1000, -1000, 430, -1000, 1478, 302, 2702, 24, 8114, 140,
-	8114, 2702, 25, 8114, 658, 540, 8114, -1000, 3747, -1000,
-	2702, 2702, 2702, 2702, 2702, 2702, 2702, 2702, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1449,156 +1445,156 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2758, 2758, -1000, -1000, 8010, -1000, -1000, -1000,
+	-1000, 704, 98, 235, -1000, 4800, 1932, 453, 453, -1000,
+	-1000, 43, -1000, -1000, 5178, 5178, 5178, 5178, 5178, 5178,
+	5178, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 453, 70, -1000, 4602, 453,
+	453, 453, 453, 453, 453, 4800, 453, 453, 453, 453,
+	453, 453, 453, 453, 453, 453, 453, 453, 453, 428,
+	-1000, 398, 668, 272, 547, 6286, 520, -1000, -1000, -31,
+	8010, -1000, 7843, 6651, 6651, 6651, 6651, 6651, -1000, 542,
+	525, -1000, 568, 558, 557, 8010, -1000, 330, 272, 6119,
+	80, 453, -1000, 6985, -1000, -1000, -31, 6651, 8010, -1000,
+	-1000, 7843, 383, -1000, -1000, -1000, -1000, 4800, 3598, 2338,
+	93, 230, -100, -1000, -1000, 457, -1000, 457, 457, 457,
+	457, -80, -80, -80, -80, -1000, -1000, -1000, -1000, -1000,
+	478, -1000, 457, 457, 457, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 476, 476, 476, 463, 463, 272, 481,
+	-1000, 8010, -1000, 645, 122, -1000, -1000, 8010, -1000, -1000,
+	8010, 2758, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 580, 4800, 4800,
+	271, 4800, 4800, 121, 5178, 240, 130, 5178, 5178, 5178,
+	5178, 5178, 5178, 5178, 5178, 5178, 5178, 5178, 5178, 5178,
+	5178, 5178, 251, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 324, -1000, 490, 898, 898, 85, 85, 85, 85,
+	85, 85, 1728, 4006, 3598, 328, 139, 4602, 4402, 4402,
+	4800, 4800, 4402, 653, 152, 139, 7676, -1000, 272, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4402, 4402, 4402, 4402,
+	4800, -1000, -1000, -1000, 618, -1000, 653, 678, -1000, 587,
+	586, 4402, -1000, 417, 453, -1000, 461, 549, 496, 502,
+	533, -1000, -1000, -1000, -1000, 523, -1000, 522, -1000, -1000,
+	-1000, -1000, -1000, 272, -1000, 29, 23, 22, 7676, -1000,
+	686, 427, -1000, -1000, -1000, 139, -1000, 60, -1000, 421,
+	2128, -1000, -1000, -1000, -1000, -1000, -1000, 469, 637, 115,
+	290, -1000, -1000, 612, -1000, 192, -102, -1000, -1000, 245,
+	-80, -80, -1000, -1000, 79, 592, 79, 79, 79, 262,
+	-1000, -1000, -1000, -1000, 232, -1000, -1000, -1000, 226, -1000,
+	-1000, 501, 7676, 2758, -1000, -1000, 114, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2702, 2702, -1000, -1000, 8114, -1000,
-	-1000, -1000, -1000, 721, 97, 274, -1000, 4734, 1168, 464,
-	464, -1000, -1000, 65, -1000, -1000, 5110, 5110, 5110, 5110,
-	5110, 5110, 5110, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 464, 73, -1000,
-	4537, 464, 464, 464, 464, 464, 464, 4734, 464, 464,
-	464, 464, 464, 464, 464, 464, 464, 464, 464, 464,
-	464, 462, -1000, 293, 693, 326, 591, 6400, 576, -1000,
-	-1000, 0, 8114, -1000, 7948, 6763, 6763, 6763, 6763, 6763,
-	-1000, 585, 582, -1000, 571, 563, 554, 8114, -1000, 424,
-	326, 6234, 87, 464, -1000, 7095, -1000, -1000, 0, 6763,
-	8114, -1000, -1000, 7948, 384, -1000, -1000, -1000, -1000, 4734,
-	3538, 2284, 160, 186, -97, -1000, -1000, 468, -1000, 468,
-	468, 468, 468, -77, -77, -77, -77, -1000, -1000, -1000,
-	-1000, -1000, 500, -1000, 468, 468, 468, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 499, 499, 499, 473, 473,
-	497, -1000, 8114, -1000, 657, 76, -1000, 8114, -1000, -1000,
-	8114, 2702, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 602, 4734, 4734,
-	344, 4734, 4734, 112, 5110, 232, 132, 5110, 5110, 5110,
-	5110, 5110, 5110, 5110, 5110, 5110, 5110, 5110, 5110, 5110,
-	5110, 5110, 277, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 294, -1000, 501, 607, 607, 81, 81, 81, 81,
-	81, 81, 5298, 3944, 3538, 414, 217, 4537, 4338, 4338,
-	4734, 4734, 4338, 685, 124, 217, 7782, -1000, 326, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 4338, 4338, 4338, 4338,
-	4734, -1000, -1000, -1000, 632, -1000, 685, 697, -1000, 614,
-	610, 4338, -1000, 494, 464, -1000, 486, 702, 515, 523,
-	962, -1000, -1000, -1000, -1000, 581, -1000, 579, -1000, -1000,
-	-1000, -1000, -1000, 326, -1000, 44, 43, 42, 7782, -1000,
-	713, 461, -1000, -1000, -1000, 217, -1000, 72, -1000, 458,
-	2075, -1000, -1000, -1000, -1000, -1000, -1000, 490, 649, 146,
-	292, -1000, -1000, 630, -1000, 164, -108, -1000, -1000, 234,
-	-77, -77, -1000, -1000, 80, 618, 80, 80, 80, 267,
-	-1000, -1000, -1000, -1000, 230, -1000, -1000, -1000, 227, -1000,
-	522, 7782, 2702, -1000, -1000, 145, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -3, -1000,
-	2702, -1000, 595, 112, 155, -1000, -1000, 298, -1000, -1000,
-	217, 217, 1322, -1000, -1000, -1000, -1000, 232, 5110, 5110,
-	5110, 28, 1322, 1305, 596, 1020, 81, 150, 150, 123,
-	123, 123, 123, 123, 211, 211, -1000, -1000, -1000, 326,
-	-1000, -1000, -1000, 326, 4338, 456, -1000, -1000, 5495, 70,
-	464, 4734, -1000, 392, 392, 270, 259, 392, 4338, 204,
-	-1000, 4734, 326, -1000, 392, 326, 392, 392, -1000, -1000,
-	8114, -1000, -1000, -1000, -1000, 452, 520, 7948, 464, -1000,
-	6046, 7782, 698, 4734, -1000, -1000, 4734, 489, -1000, 4734,
-	-1000, -1000, -1000, -1000, 464, 464, 464, 375, -1000, 698,
-	-1000, 3329, 2284, -1000, 2284, 7782, -1000, 291, -1000, -1000,
-	517, 22, -1000, -1000, -1000, 381, 80, 80, -1000, 276,
-	163, -1000, -1000, -1000, 412, -1000, 446, 407, 8114, -1000,
-	-1000, -1000, 8114, -1000, -1000, -1000, -1000, -1000, 7782, -1000,
-	-1000, -1000, -1000, -1000, -1000, 28, 1322, 1273, -1000, 5110,
-	5110, -1000, -1000, 392, 4338, -1000, -1000, 6929, -1000, -1000,
-	2911, 4338, 217, -1000, -1000, 139, 277, 139, -139, 455,
-	126, -1000, 4734, 355, -1000, -1000, -1000, -1000, -1000, -1000,
-	713, 6763, -1000, 651, 380, 396, -1000, -1000, 4141, 326,
-	390, 69, 375, 693, 217, 217, 7782, 217, 7782, 7782,
-	7782, 5858, 7782, 693, -1000, 2075, -1000, 373, -1000, 468,
-	-1000, -93, 719, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 263, 207, -1000, 200, 2702,
-	-1000, -1000, 653, -1000, 5110, 1322, 1322, -1000, -1000, -1000,
-	-1000, 68, 326, 326, 468, 468, -1000, 468, 473, -1000,
-	468, -41, 468, -59, 326, 326, 464, -136, -1000, 217,
-	4734, 711, 398, 648, -1000, 464, -1000, -1000, 557, 7782,
-	7782, -1000, -1000, 360, -1000, 301, 301, 301, 87, -1000,
-	-1000, -1000, 7782, -1000, 120, -1000, -120, -1000, 348, 334,
-	-1000, 464, 1322, 2493, -1000, -1000, -1000, 50, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 5110, 326, 257, 217,
-	701, 695, 718, -1000, 464, -1000, 501, 63, -1000, 7782,
-	-1000, -1000, -1000, -1000, -1000, -1000, 162, 647, -1000, 633,
-	-1000, -1000, -1000, -16, -1000, -1000, -1000, 2, -1000, -1000,
-	-1000, 4734, 4734, 7948, 396, 326, 7782, -1000, -1000, 241,
-	-1000, -1000, 288, -1000, 7782, 326, 20, -147, 217, 394,
-	384, -1000, -1000, -1000, -1000, -16, 608, -1000, 594, -143,
-	-151, -1000, -19, -1000, 589, -1000, -22, -145, 464, -149,
-	4922, -153, 1080, 326, -1000, -1000, -1000,
+	-1000, -32, -1000, 2758, -1000, 575, 121, 120, -1000, -1000,
+	215, -1000, -1000, 139, 139, 1318, -1000, -1000, -1000, -1000,
+	240, 5178, 5178, 5178, 559, 1318, 1175, 1188, 1084, 85,
+	75, 75, 81, 81, 81, 81, 81, 607, 607, -1000,
+	-1000, -1000, 272, -1000, -1000, -1000, 272, 4402, 418, -1000,
+	-1000, 5376, 48, 453, 4800, -1000, 303, 303, 246, 248,
+	303, 4402, 172, -1000, 4800, 272, -1000, 303, 272, 303,
+	303, -1000, -1000, 8010, -1000, -1000, -1000, -1000, 407, 493,
+	7843, 453, -1000, 5930, 7676, 677, 4800, -1000, -1000, 4800,
+	467, -1000, 4800, -1000, -1000, -1000, -1000, 453, 453, 453,
+	297, -1000, 677, -1000, 3388, 2338, -1000, 2338, 7676, -1000,
+	280, -1000, -1000, 491, 19, -1000, -1000, -1000, 361, 79,
+	79, -1000, 278, 83, -1000, -1000, -1000, 309, -1000, 413,
+	307, 8010, -1000, -1000, -1000, 8010, -1000, -1000, -1000, -1000,
+	-1000, 7676, -1000, -1000, -1000, -1000, -1000, -1000, 559, 1318,
+	1058, -1000, 5178, 5178, -1000, -1000, 303, 4402, -1000, -1000,
+	6818, -1000, -1000, 2968, 4402, 139, -1000, -1000, 132, 251,
+	132, -141, 402, 123, -1000, 4800, 194, -1000, -1000, -1000,
+	-1000, -1000, -1000, 686, 6651, -1000, 639, 380, 409, -1000,
+	-1000, 4204, 272, 300, 47, 297, 668, 139, 139, 7676,
+	139, 7676, 7676, 7676, 5741, 7676, 668, -1000, 2128, -1000,
+	295, -1000, 457, -1000, -95, 701, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 257, 213,
+	-1000, 210, 2758, -1000, -1000, 641, -1000, 5178, 1318, 1318,
+	-1000, -1000, -1000, -1000, 46, 272, 272, 457, 457, -1000,
+	457, 463, -1000, 457, -59, 457, -60, 272, 272, 453,
+	-136, -1000, 139, 4800, 683, 411, 635, -1000, 453, -1000,
+	-1000, 433, 7676, 7676, -1000, -1000, 287, -1000, 276, 276,
+	276, 80, -1000, -1000, -1000, 7676, -1000, 100, -1000, -114,
+	-1000, 331, 277, -1000, 453, 1318, 2548, -1000, -1000, -1000,
+	33, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5178,
+	272, 254, 139, 680, 674, 690, -1000, 453, -1000, 490,
+	41, -1000, 7676, -1000, -1000, -1000, -1000, -1000, -1000, 196,
+	616, -1000, 608, -1000, -1000, -1000, -36, -1000, -1000, -1000,
+	15, -1000, -1000, -1000, 4800, 4800, 7843, 409, 272, 7676,
+	-1000, -1000, 252, -1000, -1000, 269, -1000, 7676, 272, 21,
+	-148, 139, 392, 383, -1000, -1000, -1000, -1000, -36, 585,
+	-1000, 574, -144, -151, -1000, -40, -1000, 567, -1000, -45,
+	-146, 453, -149, 4989, -152, 274, 272, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 910, 24, 602, 908, 907, 906, 905, 903, 902,
-	900, 898, 895, 894, 892, 887, 886, 878, 876, 873,
-	87, 872, 870, 869, 44, 868, 56, 867, 866, 29,
-	54, 22, 26, 162, 865, 19, 74, 53, 864, 41,
-	862, 861, 860, 854, 55, 851, 846, 1095, 844, 843,
-	10, 23, 842, 841, 839, 838, 51, 46, 835, 832,
-	829, 828, 827, 825, 36, 3, 5, 4, 11, 824,
-	439, 6, 823, 34, 821, 819, 818, 816, 20, 814,
-	43, 812, 17, 42, 811, 16, 50, 33, 14, 8,
-	810, 48, 809, 569, 808, 115, 807, 806, 804, 800,
-	792, 791, 30, 300, 909, 28, 27, 789, 788, 1162,
-	32, 49, 18, 787, 40, 38, 21, 785, 782, 15,
-	780, 778, 777, 776, 775, 774, 138, 773, 772, 771,
-	9, 35, 770, 769, 57, 13, 768, 764, 763, 762,
-	45, 761, 37, 760, 759, 758, 31, 12, 757, 7,
-	756, 755, 2, 750, 747, 738, 0, 82, 737, 735,
-	165,
+var yyPgo = [...]int{
+	0, 949, 10, 560, 948, 947, 944, 942, 941, 940,
+	939, 937, 934, 912, 906, 904, 899, 897, 896, 893,
+	100, 892, 890, 889, 43, 888, 55, 887, 885, 27,
+	166, 30, 26, 24, 884, 35, 86, 99, 878, 40,
+	875, 874, 873, 871, 57, 870, 869, 1164, 868, 846,
+	8, 29, 844, 842, 841, 840, 44, 310, 839, 838,
+	830, 828, 826, 825, 37, 3, 4, 22, 12, 823,
+	51, 6, 822, 38, 820, 819, 818, 817, 69, 815,
+	41, 813, 18, 42, 812, 15, 54, 34, 23, 9,
+	807, 50, 803, 550, 801, 92, 798, 795, 793, 791,
+	790, 788, 58, 294, 674, 48, 20, 787, 780, 1202,
+	28, 59, 16, 779, 36, 33, 21, 777, 775, 19,
+	774, 771, 770, 769, 767, 761, 161, 760, 759, 757,
+	14, 46, 756, 755, 53, 13, 752, 751, 750, 748,
+	45, 744, 32, 741, 740, 739, 31, 17, 737, 7,
+	736, 735, 2, 733, 731, 729, 726, 0, 5, 717,
+	716, 167,
 }
-var yyR1 = [...]int{
 
-	0, 154, 155, 155, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]int{
+	0, 155, 156, 156, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
 	2, 3, 4, 4, 5, 5, 6, 6, 23, 23,
-	7, 8, 8, 158, 158, 42, 42, 86, 86, 9,
+	7, 8, 8, 159, 159, 42, 42, 86, 86, 9,
 	9, 9, 90, 90, 90, 108, 108, 117, 117, 10,
-	10, 10, 10, 15, 143, 144, 144, 144, 140, 120,
-	120, 120, 123, 123, 121, 121, 121, 121, 121, 121,
-	121, 122, 122, 122, 122, 122, 124, 124, 124, 124,
-	124, 125, 125, 125, 125, 125, 125, 125, 125, 125,
-	125, 125, 125, 125, 125, 139, 139, 126, 126, 134,
-	134, 135, 135, 135, 132, 132, 133, 133, 136, 136,
-	136, 127, 127, 127, 127, 127, 127, 129, 129, 137,
-	137, 130, 130, 130, 131, 131, 138, 138, 138, 138,
-	138, 128, 128, 141, 148, 148, 148, 148, 142, 142,
-	150, 150, 149, 145, 145, 145, 146, 146, 146, 147,
-	147, 147, 11, 11, 11, 11, 11, 153, 151, 151,
-	152, 152, 12, 13, 13, 13, 14, 14, 16, 17,
-	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	10, 10, 10, 10, 15, 154, 154, 143, 144, 144,
+	144, 140, 120, 120, 120, 123, 123, 121, 121, 121,
+	121, 121, 121, 121, 122, 122, 122, 122, 122, 124,
+	124, 124, 124, 124, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 139, 139,
+	126, 126, 134, 134, 135, 135, 135, 132, 132, 133,
+	133, 136, 136, 136, 127, 127, 127, 127, 127, 127,
+	129, 129, 137, 137, 130, 130, 130, 131, 131, 138,
+	138, 138, 138, 138, 128, 128, 141, 148, 148, 148,
+	148, 142, 142, 150, 150, 149, 145, 145, 145, 146,
+	146, 146, 147, 147, 147, 11, 11, 11, 11, 11,
+	11, 153, 151, 151, 152, 152, 12, 13, 13, 13,
+	14, 14, 16, 17, 17, 17, 17, 17, 17, 17,
 	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-	17, 17, 118, 118, 118, 18, 18, 19, 19, 19,
-	19, 19, 159, 20, 21, 21, 22, 22, 22, 26,
-	26, 26, 24, 24, 25, 25, 31, 31, 30, 30,
-	32, 32, 32, 32, 107, 107, 107, 106, 106, 34,
-	34, 35, 35, 36, 36, 37, 37, 37, 49, 49,
-	85, 85, 87, 87, 38, 38, 38, 38, 39, 39,
-	40, 40, 41, 41, 113, 113, 112, 112, 112, 111,
-	111, 43, 43, 43, 45, 44, 44, 44, 44, 46,
-	46, 48, 48, 47, 47, 50, 50, 50, 50, 51,
-	51, 33, 33, 33, 33, 33, 33, 33, 94, 94,
-	53, 53, 52, 52, 52, 52, 52, 52, 52, 52,
-	52, 52, 63, 63, 63, 63, 63, 63, 54, 54,
-	54, 54, 54, 54, 54, 29, 29, 64, 64, 64,
-	70, 65, 65, 57, 57, 57, 57, 57, 57, 57,
+	17, 17, 17, 17, 17, 17, 118, 118, 118, 18,
+	18, 19, 19, 19, 19, 19, 160, 20, 21, 21,
+	22, 22, 22, 26, 26, 26, 24, 24, 25, 25,
+	31, 31, 30, 30, 32, 32, 32, 32, 107, 107,
+	107, 106, 106, 34, 34, 35, 35, 36, 36, 37,
+	37, 37, 49, 49, 85, 85, 87, 87, 38, 38,
+	38, 38, 39, 39, 40, 40, 41, 41, 113, 113,
+	112, 112, 112, 111, 111, 43, 43, 43, 45, 44,
+	44, 44, 44, 46, 46, 48, 48, 47, 47, 50,
+	50, 50, 50, 51, 51, 33, 33, 33, 33, 33,
+	33, 33, 94, 94, 53, 53, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 63, 63, 63, 63,
+	63, 63, 54, 54, 54, 54, 54, 54, 54, 29,
+	29, 64, 64, 64, 70, 65, 65, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 61, 61, 61, 59, 59, 59,
-	59, 59, 59, 59, 59, 59, 60, 60, 60, 60,
-	60, 60, 60, 60, 160, 160, 62, 62, 62, 62,
-	27, 27, 27, 27, 27, 116, 116, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	74, 74, 28, 28, 72, 72, 73, 75, 75, 71,
-	71, 71, 56, 56, 56, 56, 56, 56, 56, 56,
-	58, 58, 58, 76, 76, 77, 77, 78, 78, 79,
-	79, 80, 81, 81, 81, 82, 82, 82, 82, 83,
-	83, 83, 55, 55, 55, 55, 55, 55, 84, 84,
-	84, 84, 88, 88, 66, 66, 68, 68, 67, 69,
-	89, 89, 91, 92, 92, 95, 95, 96, 96, 93,
-	93, 97, 97, 97, 97, 97, 97, 97, 97, 97,
-	97, 97, 98, 98, 98, 99, 99, 100, 100, 100,
-	101, 101, 104, 104, 105, 105, 109, 109, 110, 110,
+	57, 57, 57, 57, 57, 57, 57, 57, 61, 61,
+	61, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	60, 60, 60, 60, 60, 60, 60, 60, 161, 161,
+	62, 62, 62, 62, 27, 27, 27, 27, 27, 116,
+	116, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 74, 74, 28, 28, 72, 72,
+	73, 75, 75, 71, 71, 71, 56, 56, 56, 56,
+	56, 56, 56, 56, 58, 58, 58, 76, 76, 77,
+	77, 78, 78, 79, 79, 80, 81, 81, 81, 82,
+	82, 82, 82, 83, 83, 83, 55, 55, 55, 55,
+	55, 55, 84, 84, 84, 84, 88, 88, 66, 66,
+	68, 68, 67, 69, 89, 89, 91, 92, 92, 95,
+	95, 96, 96, 93, 93, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 98, 98,
+	98, 99, 99, 100, 100, 100, 101, 101, 104, 104,
+	105, 105, 109, 109, 110, 110, 102, 102, 102, 102,
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
@@ -1607,68 +1603,68 @@ var yyR1 = [...]int{
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
 	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
-	102, 102, 102, 102, 102, 102, 102, 102, 103, 103,
+	102, 102, 102, 102, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
 	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 156,
-	157, 114, 115, 115, 115,
+	103, 103, 103, 103, 103, 103, 157, 158, 114, 115,
+	115, 115,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 4, 6,
 	7, 10, 1, 3, 1, 3, 7, 8, 1, 1,
 	8, 8, 6, 1, 1, 1, 3, 0, 4, 3,
 	4, 5, 1, 2, 1, 1, 1, 1, 1, 2,
-	8, 4, 6, 4, 4, 1, 3, 3, 8, 3,
-	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	1, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 4, 1, 3, 0, 3, 0,
-	5, 0, 3, 5, 0, 1, 0, 1, 0, 1,
-	2, 0, 2, 2, 2, 2, 2, 0, 3, 0,
-	1, 0, 3, 3, 0, 2, 0, 2, 1, 2,
-	1, 0, 2, 4, 2, 3, 2, 2, 1, 1,
-	1, 3, 2, 0, 1, 3, 1, 2, 3, 1,
-	1, 1, 6, 7, 7, 4, 5, 7, 1, 3,
-	8, 8, 5, 4, 6, 5, 3, 2, 3, 4,
-	4, 4, 4, 4, 4, 4, 4, 3, 3, 3,
-	3, 4, 3, 3, 4, 2, 4, 2, 2, 2,
-	2, 3, 0, 1, 1, 2, 1, 2, 2, 2,
-	2, 2, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
-	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
-	2, 1, 3, 1, 1, 1, 3, 3, 3, 7,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 0, 5, 5, 5, 0,
-	2, 1, 3, 3, 2, 3, 1, 2, 0, 3,
-	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
-	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
-	3, 1, 3, 1, 1, 1, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 2, 2, 2, 3,
-	1, 1, 1, 1, 4, 5, 6, 4, 4, 6,
-	6, 6, 9, 7, 5, 4, 2, 2, 2, 2,
-	2, 2, 2, 2, 0, 2, 4, 4, 4, 4,
-	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
-	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
-	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
-	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
-	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
-	2, 4, 2, 1, 3, 5, 4, 6, 1, 3,
-	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
-	1, 3, 3, 1, 1, 0, 2, 0, 3, 0,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 8, 4, 6, 4, 2, 4, 4, 1, 3,
+	3, 8, 3, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
+	3, 3, 1, 1, 1, 1, 1, 4, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 0,
+	1, 0, 1, 2, 0, 2, 2, 2, 2, 2,
+	0, 3, 0, 1, 0, 3, 3, 0, 2, 0,
+	2, 1, 2, 1, 0, 2, 4, 2, 3, 2,
+	2, 1, 1, 1, 3, 2, 0, 1, 3, 1,
+	2, 3, 1, 1, 1, 6, 7, 7, 4, 5,
+	4, 7, 1, 3, 8, 8, 5, 4, 6, 5,
+	3, 2, 3, 4, 4, 4, 4, 4, 4, 4,
+	4, 3, 3, 3, 3, 4, 3, 3, 4, 2,
+	4, 2, 2, 2, 2, 3, 0, 1, 1, 2,
+	1, 2, 2, 2, 2, 2, 0, 2, 0, 2,
+	1, 2, 2, 0, 1, 1, 0, 1, 0, 1,
+	0, 1, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 1, 0, 2, 1, 3, 1, 1, 1,
+	3, 3, 3, 7, 1, 3, 1, 3, 4, 4,
+	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
+	3, 2, 3, 2, 2, 2, 1, 1, 3, 0,
+	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 4, 5,
+	6, 4, 4, 6, 6, 6, 9, 7, 5, 4,
+	2, 2, 2, 2, 2, 2, 2, 2, 0, 2,
+	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
+	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
+	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
+	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
+	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 0, 2, 4, 2, 1, 3, 5,
+	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
+	1, 2, 3, 1, 1, 3, 3, 1, 1, 0,
+	2, 0, 3, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 1, 0, 1, 1, 0, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -1685,240 +1681,243 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
+	1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -154, -1, -2, -6, -7, -8, -9, -10, -11,
+var yyChk = [...]int{
+	-1000, -155, -1, -2, -6, -7, -8, -9, -10, -11,
 	-12, -13, -14, -16, -17, -18, -19, -3, -4, 6,
 	-23, 8, 9, 29, -15, 111, 112, 114, 113, 131,
-	115, 124, 48, 24, 125, 126, 129, 130, -156, 7,
-	201, 52, -155, 214, -78, 14, -22, 5, -20, -159,
-	-20, -20, -20, -20, -143, 52, -100, 118, 69, 116,
-	122, -104, 55, -103, 207, 149, 143, 170, 162, 160,
-	163, 189, 64, 127, 158, 154, 152, 26, 175, 212,
-	153, 190, 147, 148, 174, 31, 209, 33, 135, 173,
-	169, 172, 146, 168, 37, 188, 165, 155, 17, 130,
-	133, 123, 137, 211, 151, 134, 129, 191, 36, 179,
-	145, 141, 166, 136, 156, 157, 171, 144, 167, 138,
-	180, 213, 164, 161, 142, 139, 140, 184, 185, 186,
-	187, 210, 159, 181, -93, 118, 120, 116, 116, 117,
-	118, 116, -47, -109, 55, -103, 118, 116, 105, 163,
-	111, 182, 117, 31, 137, -118, 116, 183, 140, 184,
-	185, 186, 187, 55, 191, 190, -109, -114, -114, -114,
-	-114, -114, -2, -82, 16, 15, -5, -3, -156, 6,
-	19, 20, -26, 38, 39, -21, -93, -35, -36, -37,
-	-38, -49, -70, -156, -47, 10, -42, -47, -89, -117,
-	-90, -91, 191, 190, 189, 163, 188, -71, -104, -109,
-	55, -103, -144, -140, 55, 117, -47, 201, -96, 121,
-	116, -47, -47, -95, 121, 55, -95, -47, 108, -47,
-	55, 29, 193, 55, 137, 116, 138, 118, -115, -156,
-	-105, -104, -102, 70, 21, 23, 177, 73, 105, 15,
-	74, 104, 202, 111, 46, 194, 195, 192, 193, 182,
-	28, 9, 24, 125, 20, 98, 113, 77, 78, 128,
-	22, 126, 68, 18, 49, 10, 12, 13, 121, 120,
-	89, 117, 44, 7, 107, 25, 86, 40, 27, 42,
-	87, 16, 196, 197, 30, 206, 132, 100, 47, 34,
-	71, 66, 50, 69, 14, 45, 88, 114, 201, 43,
-	6, 205, 29, 124, 41, 116, 183, 76, 119, 67,
-	5, 122, 8, 48, 51, 198, 199, 200, 32, 75,
-	11, -115, -115, -115, 141, 142, -115, -115, 50, -115,
-	-157, 54, -83, 18, 30, -33, -52, 71, -57, 28,
-	22, -56, -53, -71, -69, -70, 105, 106, 94, 95,
-	102, 72, 107, -61, -59, -60, -62, 57, 56, 65,
-	58, 59, 60, 61, 66, 67, 68, -104, -109, -67,
-	-156, 42, 43, 202, 203, 206, 204, 74, 32, 192,
-	200, 199, 198, 196, 197, 194, 195, 121, 193, 100,
-	201, -79, -80, -33, -78, -2, -20, 34, -24, 20,
-	63, -48, 25, -47, 29, 53, -43, -45, -44, -46,
-	40, 44, 46, 41, 42, 43, 47, -113, 21, -35,
-	-2, -156, -112, 133, -111, 21, -109, 57, -47, -158,
-	53, 10, 51, 53, -89, -108, -105, 57, 29, 79,
-	108, 54, 53, -120, -123, -125, -124, -121, -122, 160,
-	161, 105, 164, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 127, 156, 157, 158, 159, 143, 144,
-	145, 146, 147, 148, 149, 151, 152, 153, 154, 155,
-	55, -115, 118, -47, 71, -47, -115, 119, -47, 22,
+	115, 124, 48, 24, 125, 126, 129, 130, -157, 7,
+	202, 52, -156, 215, -78, 14, -22, 5, -20, -160,
+	-20, -20, -20, -20, -143, -154, 52, 87, -157, -100,
+	118, 69, 116, 122, -104, 55, -103, 208, 150, 144,
+	171, 163, 161, 164, 190, 64, 127, 159, 155, 153,
+	26, 176, 213, 154, 191, 148, 149, 175, 31, 210,
+	33, 135, 174, 170, 173, 147, 169, 37, 189, 166,
+	156, 17, 130, 133, 123, 137, 212, 152, 134, 129,
+	192, 36, 180, 146, 142, 139, 167, 136, 157, 158,
+	172, 145, 168, 138, 181, 214, 165, 162, 143, 140,
+	141, 185, 186, 187, 188, 211, 160, 182, -93, 118,
+	139, 120, 116, 116, 117, 118, 116, -47, -109, 55,
+	-103, 118, 116, 105, 164, 111, 183, 117, 31, 137,
+	-118, 116, 184, 141, 185, 186, 187, 188, 55, 192,
+	191, -109, -114, -114, -114, -114, -114, -2, -82, 16,
+	15, -5, -3, -157, 6, 19, 20, -26, 38, 39,
+	-21, -93, -35, -36, -37, -38, -49, -70, -157, -47,
+	10, -42, -47, -89, -117, -90, -91, 192, 191, 190,
+	164, 189, -71, -104, -109, 55, -103, -144, -140, 55,
+	-47, 87, 117, -47, 202, -96, 121, 116, -47, -109,
+	-47, -95, 121, 55, -95, -47, 108, -47, 55, 29,
+	194, 55, 137, 116, 138, 118, -115, -157, -105, -104,
+	-102, 70, 21, 23, 178, 73, 105, 15, 74, 104,
+	203, 111, 46, 195, 196, 193, 194, 183, 28, 9,
+	24, 125, 20, 98, 113, 77, 78, 128, 22, 126,
+	68, 18, 49, 10, 12, 13, 121, 120, 89, 117,
+	44, 7, 107, 25, 86, 40, 27, 42, 87, 16,
+	197, 198, 30, 207, 132, 100, 47, 34, 71, 66,
+	50, 69, 14, 45, 88, 114, 202, 43, 6, 206,
+	29, 124, 41, 116, 184, 76, 119, 67, 5, 122,
+	8, 48, 51, 199, 200, 201, 32, 75, 11, -115,
+	-115, -115, 142, 143, -115, -115, 50, -115, -158, 54,
+	-83, 18, 30, -33, -52, 71, -57, 28, 22, -56,
+	-53, -71, -69, -70, 105, 106, 94, 95, 102, 72,
+	107, -61, -59, -60, -62, 57, 56, 65, 58, 59,
+	60, 61, 66, 67, 68, -104, -109, -67, -157, 42,
+	43, 203, 204, 207, 205, 74, 32, 193, 201, 200,
+	199, 197, 198, 195, 196, 121, 194, 100, 202, -79,
+	-80, -33, -78, -2, -20, 34, -24, 20, 63, -48,
+	25, -47, 29, 53, -43, -45, -44, -46, 40, 44,
+	46, 41, 42, 43, 47, -113, 21, -35, -2, -157,
+	-112, 133, -111, 21, -109, 57, -47, -159, 53, 10,
+	51, 53, -89, -108, -105, 57, 29, 79, 108, 54,
+	53, -120, -123, -125, -124, -121, -122, 161, 162, 105,
+	165, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 127, 157, 158, 159, 160, 144, 145, 146, 147,
+	148, 149, 150, 152, 153, 154, 155, 156, -47, 55,
+	-115, 118, -47, 71, -47, -115, -114, 119, -47, 22,
 	50, -47, -110, -109, -102, -115, -115, -115, -115, -115,
 	-115, -115, -115, -115, -115, -47, 8, 89, 70, 69,
 	86, 53, 17, -33, -54, 89, 71, 87, 88, 73,
 	91, 90, 101, 94, 95, 96, 97, 98, 99, 100,
 	92, 93, 104, 79, 80, 81, 82, 83, 84, 85,
-	-94, -156, -70, -156, 109, 110, -57, -57, -57, -57,
-	-57, -57, -57, -156, 108, -65, -33, -156, -156, -156,
-	-156, -156, -156, -156, -74, -33, -156, -160, -156, -160,
-	-160, -160, -160, -160, -160, -160, -156, -156, -156, -156,
-	53, -81, 23, 24, -82, -157, -26, -58, -104, 58,
+	-94, -157, -70, -157, 109, 110, -57, -57, -57, -57,
+	-57, -57, -57, -157, 108, -65, -33, -157, -157, -157,
+	-157, -157, -157, -157, -74, -33, -157, -161, -157, -161,
+	-161, -161, -161, -161, -161, -161, -157, -157, -157, -157,
+	53, -81, 23, 24, -82, -158, -26, -58, -104, 58,
 	61, -25, 41, -86, 133, -47, -89, -36, -37, -37,
 	-36, -37, 40, 40, 40, 45, 40, 45, 40, -44,
-	-109, -157, -157, -2, -50, 48, 120, 49, -156, -111,
+	-109, -158, -158, -2, -50, 48, 120, 49, -157, -111,
 	-86, -35, -47, -91, -114, -33, -105, -110, -102, -145,
 	-146, -147, -105, 57, 58, -140, -141, -148, 123, 122,
-	-142, 117, 27, -136, 66, 71, -132, 180, -126, 52,
-	-126, -126, -126, -126, -130, 163, -130, -130, -130, 52,
+	-142, 117, 27, -136, 66, 71, -132, 181, -126, 52,
+	-126, -126, -126, -126, -130, 164, -130, -130, -130, 52,
 	-126, -126, -126, -134, 52, -134, -134, -135, 52, -135,
-	-101, 51, -47, 22, -97, 114, -153, 112, 177, 163,
-	64, 28, 113, 14, 202, 133, 213, 55, 134, -47,
-	-47, -115, 36, -33, -33, -63, 66, 71, 67, 68,
-	-33, -33, -57, -64, -67, -70, 62, 89, 87, 88,
-	73, -57, -57, -57, -57, -57, -57, -57, -57, -57,
-	-57, -57, -57, -57, -57, -57, -116, 55, 57, 55,
-	-56, -56, -104, -31, 20, -30, -32, 96, -33, -109,
-	-105, 53, -157, -30, -30, -33, -33, -30, -24, -72,
-	-73, 75, -104, -157, -30, -31, -30, -30, -80, -83,
-	-92, 18, 10, 32, 32, -30, -55, 29, 32, -2,
-	-156, -156, -51, 11, -40, -39, 50, 51, -41, 50,
-	-39, 40, 40, -157, 117, 117, 117, -87, -104, -51,
-	-51, 108, 53, -147, 79, 52, 27, -142, 55, 55,
-	-127, 28, 66, -133, 181, 58, -130, -130, -131, 104,
-	29, -131, -131, -131, -139, 57, 58, 58, 50, -104,
-	-115, -114, -98, -99, 119, 21, 117, 27, 133, -115,
-	37, 66, 67, 68, -64, -57, -57, -57, -29, 128,
-	70, -157, -157, -30, 53, -107, -106, 21, -104, 57,
-	108, -156, -33, -157, -157, 53, 51, 21, -157, -30,
-	-75, -73, 77, -33, -157, -157, -157, -157, -157, -47,
-	-34, 10, -88, 50, -89, -66, -68, -67, -156, -2,
-	-84, -104, -87, -78, -33, -33, 52, -33, -156, -156,
-	-156, -157, 53, -78, -105, -146, -147, -150, -149, -104,
-	55, -129, 50, 57, 58, 59, 66, 192, 54, -131,
-	-131, 55, 55, 105, 54, 53, 53, 54, 53, -47,
-	-47, -114, -104, -29, 70, -57, -57, -157, -32, -106,
-	96, -110, -31, -119, 105, 160, 127, 158, 154, 174,
-	165, 179, 156, 180, -116, -119, 207, -78, 78, -33,
-	76, -51, -35, 26, -88, 53, -157, -157, -157, 53,
-	108, -157, -82, -85, -104, -85, -85, -85, -112, -104,
-	-82, 54, 53, -126, -137, 177, 8, 57, 58, 58,
-	-115, 25, -57, 108, -157, -157, -126, -126, -126, -135,
-	-126, 148, -126, 148, -157, -157, -156, -28, 205, -33,
-	-76, 12, 27, -68, 32, -2, -156, -104, -104, 53,
-	54, -157, -157, -157, -50, -149, -138, 123, 27, 122,
-	192, 54, 54, -156, 96, -130, 55, -57, -157, 57,
-	-77, 13, 15, 8, -66, -2, 108, -104, -128, 64,
-	27, 27, -151, -152, 133, -27, 89, 210, -33, -65,
-	-89, -157, -104, 57, -157, 53, -104, -157, 208, 47,
-	211, -152, 32, 37, 209, 212, 135, 37, 136, 210,
-	-156, 211, -57, 132, 212, -157, -157,
+	-158, -101, 51, -47, 22, -97, 114, -153, 112, 178,
+	164, 64, 28, 113, 14, 203, 133, 139, 131, 214,
+	55, 134, -47, -47, -115, 36, -33, -33, -63, 66,
+	71, 67, 68, -33, -33, -57, -64, -67, -70, 62,
+	89, 87, 88, 73, -57, -57, -57, -57, -57, -57,
+	-57, -57, -57, -57, -57, -57, -57, -57, -57, -116,
+	55, 57, 55, -56, -56, -104, -31, 20, -30, -32,
+	96, -33, -109, -105, 53, -158, -30, -30, -33, -33,
+	-30, -24, -72, -73, 75, -104, -158, -30, -31, -30,
+	-30, -80, -83, -92, 18, 10, 32, 32, -30, -55,
+	29, 32, -2, -157, -157, -51, 11, -40, -39, 50,
+	51, -41, 50, -39, 40, 40, -158, 117, 117, 117,
+	-87, -104, -51, -51, 108, 53, -147, 79, 52, 27,
+	-142, 55, 55, -127, 28, 66, -133, 182, 58, -130,
+	-130, -131, 104, 29, -131, -131, -131, -139, 57, 58,
+	58, 50, -104, -115, -114, -98, -99, 119, 21, 117,
+	27, 133, -115, 37, 66, 67, 68, -64, -57, -57,
+	-57, -29, 128, 70, -158, -158, -30, 53, -107, -106,
+	21, -104, 57, 108, -157, -33, -158, -158, 53, 51,
+	21, -158, -30, -75, -73, 77, -33, -158, -158, -158,
+	-158, -158, -47, -34, 10, -88, 50, -89, -66, -68,
+	-67, -157, -2, -84, -104, -87, -78, -33, -33, 52,
+	-33, -157, -157, -157, -158, 53, -78, -105, -146, -147,
+	-150, -149, -104, 55, -129, 50, 57, 58, 59, 66,
+	193, 54, -131, -131, 55, 55, 105, 54, 53, 53,
+	54, 53, -47, -47, -114, -104, -29, 70, -57, -57,
+	-158, -32, -106, 96, -110, -31, -119, 105, 161, 127,
+	159, 155, 175, 166, 180, 157, 181, -116, -119, 208,
+	-78, 78, -33, 76, -51, -35, 26, -88, 53, -158,
+	-158, -158, 53, 108, -158, -82, -85, -104, -85, -85,
+	-85, -112, -104, -82, 54, 53, -126, -137, 178, 8,
+	57, 58, 58, -115, 25, -57, 108, -158, -158, -126,
+	-126, -126, -135, -126, 149, -126, 149, -158, -158, -157,
+	-28, 206, -33, -76, 12, 27, -68, 32, -2, -157,
+	-104, -104, 53, 54, -158, -158, -158, -50, -149, -138,
+	123, 27, 122, 193, 54, 54, -157, 96, -130, 55,
+	-57, -158, 57, -77, 13, 15, 8, -66, -2, 108,
+	-104, -128, 64, 27, 27, -151, -152, 133, -27, 89,
+	211, -33, -65, -89, -158, -104, 57, -158, 53, -104,
+	-158, 209, 47, 212, -152, 32, 37, 210, 213, 135,
+	37, 136, 211, -157, 212, -57, 132, 213, -158, -158,
 }
-var yyDef = [...]int{
 
+var yyDef = [...]int{
 	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 427, 0, 202,
-	202, 202, 202, 202, 0, 487, 469, 0, 0, 0,
-	0, 192, 196, 661, 661, 661, 661, 661, 0, 28,
-	29, 659, 1, 3, 435, 0, 0, 206, 209, 204,
-	469, 0, 0, 0, 49, 0, 0, 649, 0, 467,
-	488, 489, 492, 493, 588, 589, 590, 591, 592, 593,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 609, 610, 611, 612, 613,
-	614, 615, 616, 617, 618, 619, 620, 621, 622, 623,
-	624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
-	634, 635, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 650, 651, 652, 653, 654,
-	655, 656, 657, 658, 0, 0, 470, 0, 465, 0,
-	465, 0, 167, 273, 496, 497, 649, 0, 0, 0,
-	0, 662, 662, 662, 662, 0, 662, 662, 185, 187,
-	188, 189, 190, 662, 193, 194, 195, 197, 198, 199,
-	200, 201, 22, 439, 0, 0, 427, 24, 0, 202,
-	207, 208, 212, 210, 211, 203, 0, 0, 231, 233,
-	234, 235, 254, 0, 256, 0, 0, 35, 39, 0,
-	0, 460, -2, -2, -2, 594, -2, 0, 409, 0,
-	-2, -2, 0, 55, 0, 0, 662, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 0, 166, 0, 168,
-	662, 662, 662, 662, 662, 662, 662, 662, 177, 663,
-	664, 494, 495, 500, 501, 502, 503, 504, 505, 506,
-	507, 508, 509, 510, 511, 512, 513, 514, 515, 516,
-	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
-	527, 528, 529, 530, 531, 532, 533, 534, 535, 536,
-	537, 538, 539, 540, 541, 542, 543, 544, 545, 546,
-	547, 548, 549, 550, 551, 552, 553, 554, 555, 556,
-	557, 558, 559, 560, 561, 562, 563, 564, 565, 566,
-	567, 568, 569, 570, 571, 572, 573, 574, 575, 576,
-	577, 578, 579, 580, 581, 582, 583, 584, 585, 586,
-	587, 178, 179, 180, 662, 662, 182, 183, 0, 191,
-	23, 660, 18, 0, 0, 436, 281, 0, 286, 288,
-	0, 323, 324, 325, 326, 327, 0, 0, 0, 0,
-	0, 0, 0, 350, 351, 352, 353, 412, 413, 414,
-	415, 416, 417, 418, 419, 290, 291, 409, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 400, 0, 374,
-	374, 374, 374, 374, 374, 374, 374, 0, 0, 0,
-	0, 428, 429, 432, 435, 22, 209, 0, 214, 213,
-	205, 37, 0, 272, 0, 0, 0, 0, 0, 0,
-	261, 0, 0, 264, 0, 0, 0, 0, 255, 0,
-	22, 0, 275, 624, 257, 0, 259, 260, 37, 0,
-	0, 33, 34, 0, 40, 661, 45, 46, 43, 0,
-	0, 143, 0, 108, 104, 60, 61, 97, 63, 97,
-	97, 97, 97, 121, 121, 121, 121, 89, 90, 91,
-	92, 93, 0, 76, 97, 97, 97, 80, 64, 65,
-	66, 67, 68, 69, 70, 99, 99, 99, 101, 101,
-	490, 51, 0, 53, 0, 0, 155, 0, 163, 466,
-	0, 662, 274, 498, 499, 169, 170, 171, 172, 173,
-	174, 175, 176, 181, 184, 186, 440, 0, 0, 0,
-	0, 0, 0, 284, 0, 0, 0, 0, 0, 0,
+	11, 12, 13, 14, 15, 16, 17, 431, 0, 206,
+	206, 206, 206, 206, 0, 493, 473, 0, 0, 0,
+	0, 196, 200, 668, 668, 668, 668, 668, 0, 28,
+	29, 666, 1, 3, 439, 0, 0, 210, 213, 208,
+	473, 0, 0, 0, 49, 50, 666, 0, 0, 0,
+	656, 0, 471, 494, 495, 498, 499, 594, 595, 596,
+	597, 598, 599, 600, 601, 602, 603, 604, 605, 606,
+	607, 608, 609, 610, 611, 612, 613, 614, 615, 616,
+	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 657,
+	658, 659, 660, 661, 662, 663, 664, 665, 0, 0,
+	0, 474, 0, 469, 0, 469, 0, 171, 277, 502,
+	503, 656, 0, 0, 0, 0, 669, 669, 669, 669,
+	0, 669, 669, 189, 191, 192, 193, 194, 669, 197,
+	198, 199, 201, 202, 203, 204, 205, 22, 443, 0,
+	0, 431, 24, 0, 206, 211, 212, 216, 214, 215,
+	207, 0, 0, 235, 237, 238, 239, 258, 0, 260,
+	0, 0, 35, 39, 0, 0, 464, -2, -2, -2,
+	600, -2, 0, 413, 0, -2, -2, 0, 58, 0,
+	55, 0, 0, 669, 0, 0, 0, 0, 669, 668,
+	0, 0, 0, 0, 0, 170, 0, 172, 669, 669,
+	669, 669, 669, 669, 669, 669, 181, 670, 671, 500,
+	501, 506, 507, 508, 509, 510, 511, 512, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 531, 532, 533, 534,
+	535, 536, 537, 538, 539, 540, 541, 542, 543, 544,
+	545, 546, 547, 548, 549, 550, 551, 552, 553, 554,
+	555, 556, 557, 558, 559, 560, 561, 562, 563, 564,
+	565, 566, 567, 568, 569, 570, 571, 572, 573, 574,
+	575, 576, 577, 578, 579, 580, 581, 582, 583, 584,
+	585, 586, 587, 588, 589, 590, 591, 592, 593, 182,
+	183, 184, 669, 669, 186, 187, 0, 195, 23, 667,
+	18, 0, 0, 440, 285, 0, 290, 292, 0, 327,
+	328, 329, 330, 331, 0, 0, 0, 0, 0, 0,
+	0, 354, 355, 356, 357, 416, 417, 418, 419, 420,
+	421, 422, 423, 294, 295, 413, 0, 463, 0, 0,
+	0, 0, 0, 0, 0, 404, 0, 378, 378, 378,
+	378, 378, 378, 378, 378, 0, 0, 0, 0, 432,
+	433, 436, 439, 22, 213, 0, 218, 217, 209, 37,
+	0, 276, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 268, 0, 0, 0, 0, 259, 0, 22, 0,
+	279, 630, 261, 0, 263, 264, 37, 0, 0, 33,
+	34, 0, 40, 668, 45, 46, 43, 0, 0, 146,
+	0, 111, 107, 63, 64, 100, 66, 100, 100, 100,
+	100, 124, 124, 124, 124, 92, 93, 94, 95, 96,
+	0, 79, 100, 100, 100, 83, 67, 68, 69, 70,
+	71, 72, 73, 102, 102, 102, 104, 104, 0, 496,
+	52, 0, 54, 0, 0, 158, 160, 0, 167, 470,
+	0, 669, 278, 504, 505, 173, 174, 175, 176, 177,
+	178, 179, 180, 185, 188, 190, 444, 0, 0, 0,
+	0, 0, 0, 288, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 309, 310, 311, 312, 313, 314,
-	287, 0, 301, 0, 0, 0, 343, 344, 345, 346,
-	347, 348, 0, 216, 0, 0, 321, 0, 0, 0,
-	0, 0, 0, 212, 0, 401, 0, 366, 0, 367,
-	368, 369, 370, 371, 372, 373, 0, 216, 0, 0,
-	0, 431, 433, 434, 439, 25, 212, 0, 420, 0,
-	0, 0, 215, 0, 0, 271, 279, 232, 250, 252,
-	0, 247, 262, 263, 265, 0, 267, 0, 269, 270,
-	236, 237, 320, 22, 238, 0, 0, 0, 0, 258,
-	279, 279, 36, 461, 41, 462, 410, 0, -2, 54,
-	144, 146, 149, 150, 151, 56, 57, 0, 0, 0,
-	0, 138, 139, 111, 109, 0, 106, 105, 62, 0,
-	121, 121, 83, 84, 124, 0, 124, 124, 124, 0,
-	77, 78, 79, 71, 0, 72, 73, 74, 0, 75,
-	0, 0, 662, 468, 661, 482, 156, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 0, 162,
-	662, 165, 0, 282, 283, 285, 302, 0, 304, 306,
-	437, 438, 292, 293, 317, 318, 319, 0, 0, 0,
-	0, 315, 297, 0, 328, 329, 330, 331, 332, 333,
-	334, 335, 336, 337, 338, 339, 342, 385, 386, 0,
-	340, 341, 349, 0, 0, 217, 218, 220, 224, 0,
-	410, 0, 458, 0, 0, 0, 0, 0, 0, 407,
-	404, 0, 0, 375, 0, 0, 0, 0, 430, 19,
-	0, 463, 464, 421, 422, 229, 452, 0, 0, -2,
-	0, 0, 427, 0, 244, 251, 0, 0, 245, 0,
-	246, 266, 268, -2, 0, 0, 0, 0, 242, 427,
-	32, 0, 0, 147, 0, 0, 134, 0, 136, 137,
-	117, 0, 110, 59, 107, 0, 124, 124, 85, 0,
-	0, 86, 87, 88, 0, 95, 0, 0, 0, 491,
-	52, 152, 0, 661, 483, 484, 485, 486, 0, 164,
-	441, 303, 305, 307, 294, 315, 298, 0, 295, 0,
-	0, 289, 354, 0, 0, 221, 225, 0, 227, 228,
-	0, 216, 322, 357, 358, 0, 0, 0, 0, 427,
-	0, 405, 0, 0, 365, 376, 377, 378, 379, 20,
-	279, 0, 26, 0, 452, 442, 454, 456, 0, 22,
-	0, 448, 0, 435, 280, 248, 0, 253, 0, 0,
-	0, 256, 0, 435, 411, 145, 148, 0, 140, 97,
-	135, 119, 0, 112, 113, 114, 115, 116, 98, 81,
-	82, 125, 122, 123, 94, 0, 0, 102, 0, 662,
-	153, 154, 0, 296, 0, 316, 299, 355, 219, 226,
-	222, 0, 0, 0, 97, 97, 390, 97, 101, 393,
-	97, 395, 97, 398, 0, 0, 0, 402, 364, 408,
-	0, 423, 230, 0, 27, 0, 457, -2, 0, 0,
-	0, 38, 30, 0, 240, 0, 0, 0, 275, 243,
-	31, 133, 0, 142, 126, 120, 0, 96, 0, 0,
-	50, 0, 300, 0, 356, 359, 387, 121, 391, 392,
-	394, 396, 397, 399, 361, 360, 0, 0, 0, 406,
-	425, 0, 0, 455, 0, -2, 0, 450, 449, 0,
-	249, 276, 277, 278, 239, 141, 131, 0, 128, 130,
-	118, 100, 103, 0, 223, 388, 389, 380, 363, 403,
-	21, 0, 0, 0, 445, 22, 0, 241, 58, 0,
-	127, 129, 0, 158, 0, 0, 0, 0, 426, 424,
-	453, -2, 451, 132, 157, 0, 0, 362, 0, 0,
-	0, 159, 0, 381, 0, 384, 0, 382, 0, 0,
-	0, 0, 0, 0, 383, 160, 161,
+	0, 0, 0, 312, 313, 314, 315, 316, 317, 318,
+	291, 0, 305, 0, 0, 0, 347, 348, 349, 350,
+	351, 352, 0, 220, 0, 0, 325, 0, 0, 0,
+	0, 0, 0, 216, 0, 405, 0, 370, 0, 371,
+	372, 373, 374, 375, 376, 377, 0, 220, 0, 0,
+	0, 435, 437, 438, 443, 25, 216, 0, 424, 0,
+	0, 0, 219, 0, 0, 275, 283, 236, 254, 256,
+	0, 251, 266, 267, 269, 0, 271, 0, 273, 274,
+	240, 241, 324, 22, 242, 0, 0, 0, 0, 262,
+	283, 283, 36, 465, 41, 466, 414, 0, -2, 57,
+	147, 149, 152, 153, 154, 59, 60, 0, 0, 0,
+	0, 141, 142, 114, 112, 0, 109, 108, 65, 0,
+	124, 124, 86, 87, 127, 0, 127, 127, 127, 0,
+	80, 81, 82, 74, 0, 75, 76, 77, 0, 78,
+	56, 0, 0, 669, 472, 668, 488, 159, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 0, 166, 669, 169, 0, 286, 287, 289, 306,
+	0, 308, 310, 441, 442, 296, 297, 321, 322, 323,
+	0, 0, 0, 0, 319, 301, 0, 332, 333, 334,
+	335, 336, 337, 338, 339, 340, 341, 342, 343, 346,
+	389, 390, 0, 344, 345, 353, 0, 0, 221, 222,
+	224, 228, 0, 414, 0, 462, 0, 0, 0, 0,
+	0, 0, 411, 408, 0, 0, 379, 0, 0, 0,
+	0, 434, 19, 0, 467, 468, 425, 426, 233, 456,
+	0, 0, -2, 0, 0, 431, 0, 248, 255, 0,
+	0, 249, 0, 250, 270, 272, -2, 0, 0, 0,
+	0, 246, 431, 32, 0, 0, 150, 0, 0, 137,
+	0, 139, 140, 120, 0, 113, 62, 110, 0, 127,
+	127, 88, 0, 0, 89, 90, 91, 0, 98, 0,
+	0, 0, 497, 53, 155, 0, 668, 489, 490, 491,
+	492, 0, 168, 445, 307, 309, 311, 298, 319, 302,
+	0, 299, 0, 0, 293, 358, 0, 0, 225, 229,
+	0, 231, 232, 0, 220, 326, 361, 362, 0, 0,
+	0, 0, 431, 0, 409, 0, 0, 369, 380, 381,
+	382, 383, 20, 283, 0, 26, 0, 456, 446, 458,
+	460, 0, 22, 0, 452, 0, 439, 284, 252, 0,
+	257, 0, 0, 0, 260, 0, 439, 415, 148, 151,
+	0, 143, 100, 138, 122, 0, 115, 116, 117, 118,
+	119, 101, 84, 85, 128, 125, 126, 97, 0, 0,
+	105, 0, 669, 156, 157, 0, 300, 0, 320, 303,
+	359, 223, 230, 226, 0, 0, 0, 100, 100, 394,
+	100, 104, 397, 100, 399, 100, 402, 0, 0, 0,
+	406, 368, 412, 0, 427, 234, 0, 27, 0, 461,
+	-2, 0, 0, 0, 38, 30, 0, 244, 0, 0,
+	0, 279, 247, 31, 136, 0, 145, 129, 123, 0,
+	99, 0, 0, 51, 0, 304, 0, 360, 363, 391,
+	124, 395, 396, 398, 400, 401, 403, 365, 364, 0,
+	0, 0, 410, 429, 0, 0, 459, 0, -2, 0,
+	454, 453, 0, 253, 280, 281, 282, 243, 144, 134,
+	0, 131, 133, 121, 103, 106, 0, 227, 392, 393,
+	384, 367, 407, 21, 0, 0, 0, 449, 22, 0,
+	245, 61, 0, 130, 132, 0, 162, 0, 0, 0,
+	0, 430, 428, 457, -2, 455, 135, 161, 0, 0,
+	366, 0, 0, 0, 163, 0, 385, 0, 388, 0,
+	386, 0, 0, 0, 0, 0, 0, 387, 164, 165,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 72, 3, 3, 3, 99, 91, 3,
 	52, 54, 96, 94, 53, 95, 108, 97, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 214,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 215,
 	80, 79, 81, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -1927,8 +1926,8 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 90, 3, 102,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1948,8 +1947,9 @@ var yyTok2 = [...]int{
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
 	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213,
+	209, 210, 211, 212, 213, 214,
 }
+
 var yyTok3 = [...]int{
 	0,
 }
@@ -2293,29 +2293,29 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:277
+//line sql.y:279
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:282
+//line sql.y:284
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:283
+//line sql.y:285
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:287
+//line sql.y:289
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:306
+//line sql.y:308
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -2325,49 +2325,49 @@ yydefault:
 		}
 	case 19:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:314
+//line sql.y:316
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 20:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:318
+//line sql.y:320
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
 	case 21:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line sql.y:325
+//line sql.y:327
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:331
+//line sql.y:333
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:335
+//line sql.y:337
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:341
+//line sql.y:343
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:345
+//line sql.y:347
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 26:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:352
+//line sql.y:354
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -2381,7 +2381,7 @@ yydefault:
 		}
 	case 27:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:364
+//line sql.y:366
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -2393,172 +2393,192 @@ yydefault:
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:376
+//line sql.y:378
 		{
 			yyVAL.str = InsertStr
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:380
+//line sql.y:382
 		{
 			yyVAL.str = ReplaceStr
 		}
 	case 30:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:386
+//line sql.y:388
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 31:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:392
+//line sql.y:394
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 32:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:396
+//line sql.y:398
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:401
+//line sql.y:403
 		{
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:402
+//line sql.y:404
 		{
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:406
+//line sql.y:408
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:410
+//line sql.y:412
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:415
+//line sql.y:417
 		{
 			yyVAL.partitions = nil
 		}
 	case 38:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:419
+//line sql.y:421
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
 	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:425
+//line sql.y:427
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].updateExprs}
 		}
 	case 40:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:429
+//line sql.y:431
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].updateExprs}
 		}
 	case 41:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:433
+//line sql.y:435
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Charset: yyDollar[4].colIdent}
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:444
+//line sql.y:446
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:448
+//line sql.y:450
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:454
+//line sql.y:456
 		{
 			yyVAL.str = SessionStr
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:458
+//line sql.y:460
 		{
 			yyVAL.str = GlobalStr
 		}
 	case 49:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:464
+//line sql.y:466
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
 	case 50:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:471
+		{
+			// Create table [name] like [name]
+			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:469
+//line sql.y:477
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:474
+//line sql.y:482
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:478
+//line sql.y:486
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
 		}
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:484
+//line sql.y:492
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 54:
+	case 55:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:499
+		{
+			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
+		}
+	case 56:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:503
+		{
+			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
+		}
+	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:491
+//line sql.y:509
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:498
+//line sql.y:516
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:503
+//line sql.y:521
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:507
+//line sql.y:525
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:513
+//line sql.y:531
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -2568,645 +2588,654 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].optVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:524
+//line sql.y:542
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:534
+//line sql.y:552
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:539
+//line sql.y:557
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:545
+//line sql.y:563
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:549
+//line sql.y:567
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:553
+//line sql.y:571
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:557
+//line sql.y:575
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:561
+//line sql.y:579
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:565
+//line sql.y:583
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:569
+//line sql.y:587
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:575
+//line sql.y:593
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:581
+//line sql.y:599
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:587
+//line sql.y:605
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:593
+//line sql.y:611
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:599
+//line sql.y:617
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:607
+//line sql.y:625
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:611
+//line sql.y:629
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:615
+//line sql.y:633
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:619
+//line sql.y:637
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:623
+//line sql.y:641
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:629
+//line sql.y:647
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:633
+//line sql.y:651
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:637
+//line sql.y:655
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:641
+//line sql.y:659
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:645
+//line sql.y:663
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:649
+//line sql.y:667
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:653
+//line sql.y:671
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:657
+//line sql.y:675
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:661
+//line sql.y:679
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:665
+//line sql.y:683
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:669
+//line sql.y:687
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:673
+//line sql.y:691
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:677
+//line sql.y:695
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:681
+//line sql.y:699
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs}
 		}
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:687
+//line sql.y:705
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:692
+//line sql.y:710
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:697
+//line sql.y:715
 		{
 			yyVAL.optVal = nil
 		}
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:701
+//line sql.y:719
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:706
+//line sql.y:724
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:710
+//line sql.y:728
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:718
+//line sql.y:736
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:722
+//line sql.y:740
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:728
+//line sql.y:746
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:736
+//line sql.y:754
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:740
+//line sql.y:758
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:745
+//line sql.y:763
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:749
+//line sql.y:767
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:755
+//line sql.y:773
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:759
+//line sql.y:777
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:763
+//line sql.y:781
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:768
+//line sql.y:786
 		{
 			yyVAL.optVal = nil
 		}
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:772
+//line sql.y:790
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:776
+//line sql.y:794
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:780
+//line sql.y:798
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:784
+//line sql.y:802
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 116:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:788
+//line sql.y:806
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:793
+//line sql.y:811
 		{
 			yyVAL.optVal = nil
 		}
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:797
+//line sql.y:815
 		{
 			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
 		}
-	case 119:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:802
+//line sql.y:820
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 120:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:806
+//line sql.y:824
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 121:
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:811
+//line sql.y:829
 		{
 			yyVAL.str = ""
 		}
-	case 122:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:815
+//line sql.y:833
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 123:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:819
+//line sql.y:837
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 124:
+	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:824
+//line sql.y:842
 		{
 			yyVAL.str = ""
 		}
-	case 125:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:828
+//line sql.y:846
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 126:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:833
+//line sql.y:851
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 127:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:837
+//line sql.y:855
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 128:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:841
+//line sql.y:859
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 129:
+	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:845
+//line sql.y:863
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 130:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:849
+//line sql.y:867
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 131:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:854
+//line sql.y:872
 		{
 			yyVAL.optVal = nil
 		}
-	case 132:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:858
+//line sql.y:876
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 133:
+	case 136:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:864
+//line sql.y:882
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 134:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:870
+//line sql.y:888
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 135:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:874
+//line sql.y:892
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 136:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:878
+//line sql.y:896
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 137:
+	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:882
+//line sql.y:900
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 138:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:888
+//line sql.y:906
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 139:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:892
+//line sql.y:910
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 140:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:898
+//line sql.y:916
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 141:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:902
+//line sql.y:920
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 142:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:908
+//line sql.y:926
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
 		}
-	case 143:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:913
+//line sql.y:931
 		{
 			yyVAL.str = ""
 		}
-	case 144:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:917
+//line sql.y:935
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:921
+//line sql.y:939
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:929
+//line sql.y:947
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:933
+//line sql.y:951
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:937
+//line sql.y:955
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:943
+//line sql.y:961
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:947
+//line sql.y:965
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 151:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:951
+//line sql.y:969
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 152:
+	case 155:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:957
+//line sql.y:975
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 153:
+	case 156:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:961
+//line sql.y:979
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 154:
+	case 157:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:966
+//line sql.y:984
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 155:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:971
+//line sql.y:989
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 156:
+	case 159:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:975
+//line sql.y:993
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 157:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:981
+	case 160:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:997
+		{
+			// Tablespace changes (e.g. ENCRYPTION) rewrite the storage of
+			// every table in it. Without a table name, this results in a
+			// reload of the whole schema.
+			yyVAL.statement = &DDL{Action: AlterStr}
+		}
+	case 161:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1006
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 158:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:987
+//line sql.y:1012
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 159:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:991
+//line sql.y:1016
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 160:
+	case 164:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:997
+//line sql.y:1022
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 161:
+	case 165:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1001
+//line sql.y:1026
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 162:
+	case 166:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1007
+//line sql.y:1032
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 163:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1013
+//line sql.y:1038
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3214,16 +3243,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 164:
+	case 168:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1021
+//line sql.y:1046
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 165:
+	case 169:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1026
+//line sql.y:1051
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3231,608 +3260,608 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 166:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1036
+//line sql.y:1061
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 167:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1040
+//line sql.y:1065
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 168:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1045
+//line sql.y:1070
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 169:
+	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1051
+//line sql.y:1076
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 170:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1055
+//line sql.y:1080
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 171:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1059
+//line sql.y:1084
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 172:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1064
+//line sql.y:1089
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 173:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1068
+//line sql.y:1093
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 174:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1072
+//line sql.y:1097
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 175:
+	case 179:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1076
+//line sql.y:1101
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 176:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1080
+//line sql.y:1105
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 177:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1084
+//line sql.y:1109
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 178:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1088
+//line sql.y:1113
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 179:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1092
+//line sql.y:1117
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 180:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1096
+//line sql.y:1121
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 181:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1100
+//line sql.y:1125
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 182:
+	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1104
+//line sql.y:1129
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 183:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1108
+//line sql.y:1133
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 184:
+	case 188:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1112
+//line sql.y:1137
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 185:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1116
+//line sql.y:1141
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 186:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1120
+//line sql.y:1145
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 187:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1124
+//line sql.y:1149
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 188:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1128
+//line sql.y:1153
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 189:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1132
+//line sql.y:1157
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 190:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1136
+//line sql.y:1161
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 191:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1146
+//line sql.y:1171
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 192:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1152
+//line sql.y:1177
 		{
 			yyVAL.str = ""
 		}
-	case 193:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1156
+//line sql.y:1181
 		{
 			yyVAL.str = SessionStr
 		}
-	case 194:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1160
+//line sql.y:1185
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 195:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1166
+//line sql.y:1191
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 196:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1170
+//line sql.y:1195
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 197:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1176
+//line sql.y:1201
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 198:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1180
+//line sql.y:1205
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 199:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1184
+//line sql.y:1209
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 200:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1188
+//line sql.y:1213
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 201:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1192
+//line sql.y:1217
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 202:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1197
+//line sql.y:1222
 		{
 			setAllowComments(yylex, true)
 		}
-	case 203:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1201
+//line sql.y:1226
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 204:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1207
+//line sql.y:1232
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 205:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1211
+//line sql.y:1236
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 206:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1217
+//line sql.y:1242
 		{
 			yyVAL.str = UnionStr
 		}
-	case 207:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1221
+//line sql.y:1246
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 208:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1225
+//line sql.y:1250
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 209:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1230
+//line sql.y:1255
 		{
 			yyVAL.str = ""
 		}
-	case 210:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1234
+//line sql.y:1259
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 211:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1238
+//line sql.y:1263
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 212:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1243
+//line sql.y:1268
 		{
 			yyVAL.str = ""
 		}
-	case 213:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1247
+//line sql.y:1272
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 214:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1252
+//line sql.y:1277
 		{
 			yyVAL.str = ""
 		}
-	case 215:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1256
+//line sql.y:1281
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 216:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1261
+//line sql.y:1286
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 217:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1265
+//line sql.y:1290
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 218:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1271
+//line sql.y:1296
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 219:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1275
+//line sql.y:1300
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 220:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1281
+//line sql.y:1306
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 221:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1285
+//line sql.y:1310
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 222:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1289
+//line sql.y:1314
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 223:
+	case 227:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1293
+//line sql.y:1318
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 224:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1298
+//line sql.y:1323
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 225:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1302
+//line sql.y:1327
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 226:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1306
+//line sql.y:1331
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 228:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1313
+//line sql.y:1338
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 229:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1318
+//line sql.y:1343
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 230:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1322
+//line sql.y:1347
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 231:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1328
+//line sql.y:1353
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 232:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1332
+//line sql.y:1357
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 235:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1342
+//line sql.y:1367
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 236:
+	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1346
+//line sql.y:1371
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 237:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1350
+//line sql.y:1375
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 238:
+	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1356
+//line sql.y:1381
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 239:
+	case 243:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1360
+//line sql.y:1385
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 240:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1366
+//line sql.y:1391
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 241:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1370
+//line sql.y:1395
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 242:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1376
+//line sql.y:1401
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 243:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1380
+//line sql.y:1405
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 244:
+	case 248:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1393
+//line sql.y:1418
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 245:
+	case 249:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1397
+//line sql.y:1422
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 246:
+	case 250:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1401
+//line sql.y:1426
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 247:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1405
+//line sql.y:1430
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 248:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1411
+//line sql.y:1436
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 249:
+	case 253:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1413
+//line sql.y:1438
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 250:
+	case 254:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1417
+//line sql.y:1442
 		{
 		}
-	case 251:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1419
+//line sql.y:1444
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 252:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1423
+//line sql.y:1448
 		{
 		}
-	case 253:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1425
+//line sql.y:1450
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 254:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1428
+//line sql.y:1453
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 255:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1430
+//line sql.y:1455
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 256:
+	case 260:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1433
+//line sql.y:1458
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 257:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1437
+//line sql.y:1462
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 258:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1441
+//line sql.y:1466
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 260:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1448
+//line sql.y:1473
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 261:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1454
+//line sql.y:1479
 		{
 			yyVAL.str = JoinStr
 		}
-	case 262:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1458
+//line sql.y:1483
 		{
 			yyVAL.str = JoinStr
 		}
-	case 263:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1462
+//line sql.y:1487
 		{
 			yyVAL.str = JoinStr
 		}
-	case 264:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1468
+//line sql.y:1493
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 265:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1474
+//line sql.y:1499
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 266:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1478
+//line sql.y:1503
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 267:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1482
+//line sql.y:1507
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 268:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1486
+//line sql.y:1511
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 269:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1492
+//line sql.y:1517
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 270:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1496
+//line sql.y:1521
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -3840,453 +3869,453 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 271:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1506
+//line sql.y:1531
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 272:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1510
+//line sql.y:1535
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 273:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1516
+//line sql.y:1541
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 274:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1520
+//line sql.y:1545
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 275:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1525
+//line sql.y:1550
 		{
 			yyVAL.indexHints = nil
 		}
-	case 276:
+	case 280:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1529
+//line sql.y:1554
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 277:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1533
+//line sql.y:1558
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 278:
+	case 282:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1537
+//line sql.y:1562
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 279:
+	case 283:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1542
+//line sql.y:1567
 		{
 			yyVAL.expr = nil
 		}
-	case 280:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1546
+//line sql.y:1571
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 281:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1552
+//line sql.y:1577
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 282:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1556
+//line sql.y:1581
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 283:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1560
+//line sql.y:1585
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 284:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1564
+//line sql.y:1589
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 285:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1568
+//line sql.y:1593
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 286:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1572
+//line sql.y:1597
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 287:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1576
+//line sql.y:1601
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 288:
+	case 292:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1582
+//line sql.y:1607
 		{
 			yyVAL.str = ""
 		}
-	case 289:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1586
+//line sql.y:1611
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 290:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1592
+//line sql.y:1617
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 291:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1596
+//line sql.y:1621
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 292:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1602
+//line sql.y:1627
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 293:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1606
+//line sql.y:1631
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 294:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1610
+//line sql.y:1635
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 295:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1614
+//line sql.y:1639
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 296:
+	case 300:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1618
+//line sql.y:1643
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 297:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1622
+//line sql.y:1647
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 298:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1626
+//line sql.y:1651
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 299:
+	case 303:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1630
+//line sql.y:1655
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 300:
+	case 304:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1634
+//line sql.y:1659
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 301:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1638
+//line sql.y:1663
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 302:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1644
+//line sql.y:1669
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 303:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1648
+//line sql.y:1673
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 304:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1652
+//line sql.y:1677
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 305:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1656
+//line sql.y:1681
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 306:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1660
+//line sql.y:1685
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 307:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1664
+//line sql.y:1689
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 308:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1670
+//line sql.y:1695
 		{
 			yyVAL.str = EqualStr
 		}
-	case 309:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1674
+//line sql.y:1699
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 310:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1678
+//line sql.y:1703
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 311:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1682
+//line sql.y:1707
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 312:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1686
+//line sql.y:1711
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 313:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1690
+//line sql.y:1715
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 314:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1694
+//line sql.y:1719
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 315:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1699
+//line sql.y:1724
 		{
 			yyVAL.expr = nil
 		}
-	case 316:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1703
+//line sql.y:1728
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 317:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1709
+//line sql.y:1734
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 318:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1713
+//line sql.y:1738
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 319:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1717
+//line sql.y:1742
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 320:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1723
+//line sql.y:1748
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 321:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1729
+//line sql.y:1754
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 322:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1733
+//line sql.y:1758
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 323:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1739
+//line sql.y:1764
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 324:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1743
+//line sql.y:1768
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 325:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1747
+//line sql.y:1772
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 326:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1751
+//line sql.y:1776
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 327:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1755
+//line sql.y:1780
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 328:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1759
+//line sql.y:1784
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 329:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1763
+//line sql.y:1788
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 330:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1767
+//line sql.y:1792
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 331:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1771
+//line sql.y:1796
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 332:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1775
+//line sql.y:1800
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 333:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1779
+//line sql.y:1804
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 334:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1783
+//line sql.y:1808
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 335:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1787
+//line sql.y:1812
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 336:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1791
+//line sql.y:1816
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 337:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1795
+//line sql.y:1820
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 338:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1799
+//line sql.y:1824
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 339:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1803
+//line sql.y:1828
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 340:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1807
+//line sql.y:1832
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 341:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1811
+//line sql.y:1836
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 342:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1815
+//line sql.y:1840
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 343:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1819
+//line sql.y:1844
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 344:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1823
+//line sql.y:1848
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 345:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1827
+//line sql.y:1852
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -4294,9 +4323,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 346:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1835
+//line sql.y:1860
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -4310,21 +4339,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 347:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1849
+//line sql.y:1874
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 348:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1853
+//line sql.y:1878
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 349:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1857
+//line sql.y:1882
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -4332,395 +4361,395 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 354:
+	case 358:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1875
+//line sql.y:1900
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 355:
+	case 359:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1879
+//line sql.y:1904
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 356:
+	case 360:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1883
+//line sql.y:1908
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 357:
+	case 361:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1893
+//line sql.y:1918
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 358:
+	case 362:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1897
+//line sql.y:1922
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 359:
+	case 363:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1901
+//line sql.y:1926
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 360:
+	case 364:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1905
+//line sql.y:1930
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 361:
+	case 365:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1909
+//line sql.y:1934
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 362:
+	case 366:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:1913
+//line sql.y:1938
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 363:
+	case 367:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1917
+//line sql.y:1942
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 364:
+	case 368:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1921
+//line sql.y:1946
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 365:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1925
+//line sql.y:1950
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colIdent}
 		}
-	case 366:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1935
+//line sql.y:1960
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 367:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1939
+//line sql.y:1964
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 368:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1943
+//line sql.y:1968
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 369:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1947
+//line sql.y:1972
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 370:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1952
+//line sql.y:1977
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 371:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1957
+//line sql.y:1982
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 372:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1962
+//line sql.y:1987
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 373:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1967
+//line sql.y:1992
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 376:
+	case 380:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1981
+//line sql.y:2006
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 377:
+	case 381:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1985
+//line sql.y:2010
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 378:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1989
+//line sql.y:2014
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 379:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1993
+//line sql.y:2018
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 380:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1999
+//line sql.y:2024
 		{
 			yyVAL.str = ""
 		}
-	case 381:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2003
+//line sql.y:2028
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 382:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2007
+//line sql.y:2032
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 383:
+	case 387:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2011
+//line sql.y:2036
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 384:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2015
+//line sql.y:2040
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 385:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2021
+//line sql.y:2046
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 386:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2025
+//line sql.y:2050
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 387:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2031
+//line sql.y:2056
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 388:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2035
+//line sql.y:2060
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 389:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2039
+//line sql.y:2064
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 390:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2043
+//line sql.y:2068
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 391:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2047
+//line sql.y:2072
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 392:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2051
+//line sql.y:2076
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 393:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2057
+//line sql.y:2082
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 394:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2061
+//line sql.y:2086
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 395:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2065
+//line sql.y:2090
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 396:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2069
+//line sql.y:2094
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 397:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2073
+//line sql.y:2098
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 398:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2077
+//line sql.y:2102
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 399:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2081
+//line sql.y:2106
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 400:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2086
+//line sql.y:2111
 		{
 			yyVAL.expr = nil
 		}
-	case 401:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2090
+//line sql.y:2115
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 402:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2095
+//line sql.y:2120
 		{
 			yyVAL.str = string("")
 		}
-	case 403:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2099
+//line sql.y:2124
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 404:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2105
+//line sql.y:2130
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 405:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2109
+//line sql.y:2134
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 406:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2115
+//line sql.y:2140
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 407:
+	case 411:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2120
+//line sql.y:2145
 		{
 			yyVAL.expr = nil
 		}
-	case 408:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2124
+//line sql.y:2149
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 409:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2130
+//line sql.y:2155
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 410:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2134
+//line sql.y:2159
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 411:
+	case 415:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2138
+//line sql.y:2163
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 412:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2144
+//line sql.y:2169
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 413:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2148
+//line sql.y:2173
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 414:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2152
+//line sql.y:2177
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 415:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2156
+//line sql.y:2181
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 416:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2160
+//line sql.y:2185
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 417:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2164
+//line sql.y:2189
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 418:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2168
+//line sql.y:2193
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 419:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2172
+//line sql.y:2197
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 420:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2178
+//line sql.y:2203
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -4729,239 +4758,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 421:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2187
+//line sql.y:2212
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 422:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2191
+//line sql.y:2216
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 423:
+	case 427:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2196
+//line sql.y:2221
 		{
 			yyVAL.exprs = nil
 		}
-	case 424:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2200
+//line sql.y:2225
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 425:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2205
+//line sql.y:2230
 		{
 			yyVAL.expr = nil
 		}
-	case 426:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2209
+//line sql.y:2234
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 427:
+	case 431:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2214
+//line sql.y:2239
 		{
 			yyVAL.orderBy = nil
 		}
-	case 428:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2218
+//line sql.y:2243
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 429:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2224
+//line sql.y:2249
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 430:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2228
+//line sql.y:2253
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 431:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2234
+//line sql.y:2259
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 432:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2239
+//line sql.y:2264
 		{
 			yyVAL.str = AscScr
 		}
-	case 433:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2243
+//line sql.y:2268
 		{
 			yyVAL.str = AscScr
 		}
-	case 434:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2247
+//line sql.y:2272
 		{
 			yyVAL.str = DescScr
 		}
-	case 435:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2252
+//line sql.y:2277
 		{
 			yyVAL.limit = nil
 		}
-	case 436:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2256
+//line sql.y:2281
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 437:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2260
+//line sql.y:2285
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 438:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2264
+//line sql.y:2289
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 439:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2269
+//line sql.y:2294
 		{
 			yyVAL.str = ""
 		}
-	case 440:
+	case 444:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2273
+//line sql.y:2298
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 441:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2277
+//line sql.y:2302
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 442:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2290
+//line sql.y:2315
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 443:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2294
+//line sql.y:2319
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 444:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2298
+//line sql.y:2323
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 445:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2303
+//line sql.y:2328
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 446:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2307
+//line sql.y:2332
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 447:
+	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2311
+//line sql.y:2336
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 448:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2318
+//line sql.y:2343
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 449:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2322
+//line sql.y:2347
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 450:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2326
+//line sql.y:2351
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 451:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2330
+//line sql.y:2355
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 452:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2335
+//line sql.y:2360
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 453:
+	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2339
+//line sql.y:2364
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 454:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2345
+//line sql.y:2370
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 455:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2349
+//line sql.y:2374
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 456:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2355
+//line sql.y:2380
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 457:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2359
+//line sql.y:2384
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 458:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2365
+//line sql.y:2390
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 459:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2371
+//line sql.y:2396
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -4969,258 +4998,270 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 460:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2381
+//line sql.y:2406
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 461:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2385
+//line sql.y:2410
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 462:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2391
+//line sql.y:2416
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 465:
+	case 469:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2400
+//line sql.y:2425
 		{
 			yyVAL.byt = 0
 		}
-	case 466:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2402
+//line sql.y:2427
 		{
 			yyVAL.byt = 1
 		}
-	case 467:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2405
+//line sql.y:2430
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 468:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2407
+//line sql.y:2432
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 469:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2410
+//line sql.y:2435
 		{
 			yyVAL.str = ""
 		}
-	case 470:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2412
+//line sql.y:2437
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 471:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2416
+//line sql.y:2441
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 472:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2418
+//line sql.y:2443
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 473:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2420
+//line sql.y:2445
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 474:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2422
+//line sql.y:2447
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 475:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2424
+//line sql.y:2449
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 476:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2426
+//line sql.y:2451
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 477:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2428
+//line sql.y:2453
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 478:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2430
+//line sql.y:2455
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 479:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2432
+//line sql.y:2457
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2434
+//line sql.y:2459
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 481:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2436
+//line sql.y:2461
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 482:
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2463
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2465
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2439
+//line sql.y:2468
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 483:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2441
+//line sql.y:2470
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 484:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2443
+//line sql.y:2472
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 485:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2447
+//line sql.y:2476
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 486:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2449
+//line sql.y:2478
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 487:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2452
+//line sql.y:2481
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 488:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2454
+//line sql.y:2483
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2456
+//line sql.y:2485
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2459
+//line sql.y:2488
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2461
+//line sql.y:2490
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 492:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2465
+//line sql.y:2494
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 493:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2469
+//line sql.y:2498
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 495:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2476
+//line sql.y:2505
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 496:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2482
+//line sql.y:2511
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 497:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2486
+//line sql.y:2515
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 499:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2493
+//line sql.y:2522
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 659:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2678
+//line sql.y:2708
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 660:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2687
+//line sql.y:2717
 		{
 			decNesting(yylex)
 		}
-	case 661:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2692
+//line sql.y:2722
 		{
 			forceEOF(yylex)
 		}
-	case 662:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2697
+//line sql.y:2727
 		{
 			forceEOF(yylex)
 		}
-	case 663:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2701
+//line sql.y:2731
 		{
 			forceEOF(yylex)
 		}
-	case 664:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2705
+//line sql.y:2735
 		{
 			forceEOF(yylex)
 		}
@@ -103,6 +103,7 @@ func forceEOF(yylex interface{}) {
   partDefs      []*PartitionDefinition
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  optLike       *OptLike
 }
 
 %token LEX_ERROR
@@ -148,7 +149,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> CREATE ALTER DROP RENAME ANALYZE
 %token <bytes> TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER TABLESPACE
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES
 
@@ -267,6 +268,7 @@ func forceEOF(yylex interface{}) {
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
+%type <optLike> create_like
 
 %start any_command
 
@@ -465,6 +467,12 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
+| create_table_prefix create_like
+  {
+    // Create table [name] like [name]
+    $1.OptLike = $2
+    $$ = $1
+  }
 | CREATE constraint_opt INDEX ID using_opt ON table_name ddl_force_eof
   {
     // Change this to an alter statement
@@ -486,6 +494,16 @@ create_table_prefix:
     setDDL(yylex, $$)
   }
 
+create_like:
+  LIKE table_name
+  {
+    $$ = &OptLike{LikeTable: $2}
+  }
+| openb LIKE table_name closeb
+  {
+    $$ = &OptLike{LikeTable: $3}
+  }
+
 table_spec:
   '(' table_column_list ')' table_option_list
   {
@@ -975,6 +993,13 @@ alter_statement:
   {
     $$ = &DDL{Action: AlterStr, Table: $4, PartitionSpec: $5}
   }
+| ALTER TABLESPACE table_id force_eof
+  {
+    // Tablespace changes (e.g. ENCRYPTION) rewrite the storage of
+    // every table in it. Without a table name, this results in a
+    // reload of the whole schema.
+    $$ = &DDL{Action: AlterStr}
+  }
 
 partition_operation:
   REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
@@ -2430,6 +2455,10 @@ non_rename_operation:
   { $$ = struct{}{} }
 | PARTITION
   { $$ = struct{}{} }
+| TABLESPACE
+  { $$ = struct{}{} }
+| TRUNCATE
+  { $$ = struct{}{} }
 | UNUSED
   { $$ = struct{}{} }
 | ID
@@ -2649,6 +2678,7 @@ non_reserved_keyword:
 | SIGNED
 | SMALLINT
 | STATUS
+| TABLESPACE
 | TEXT
 | THAN
 | TIME
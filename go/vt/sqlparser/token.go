@@ -315,6 +315,7 @@ var keywords = map[string]int{
 	"straight_join":       STRAIGHT_JOIN,
 	"table":               TABLE,
 	"tables":              TABLES,
+	"tablespace":          TABLESPACE,
 	"terminated":          UNUSED,
 	"text":                TEXT,
 	"than":                THAN,
@@ -177,12 +177,12 @@ func TestQueryRules(t *testing.T) {
 	query := "select * from vitess_test where intval=:asdfg"
 	bv := map[string]*querypb.BindVariable{"asdfg": sqltypes.Int64BindVariable(1)}
 	_, err = client.Execute(query, bv)
-	want = "disallowed due to rule: disallow bindvar 'asdfg', CallerID: dev"
+	want = "disallowed due to rule r1: disallow bindvar 'asdfg', CallerID: dev"
 	if err == nil || err.Error() != want {
 		t.Errorf("Error: %v, want %s", err, want)
 	}
 	_, err = client.StreamExecute(query, bv)
-	want = "disallowed due to rule: disallow bindvar 'asdfg', CallerID: dev"
+	want = "disallowed due to rule r1: disallow bindvar 'asdfg', CallerID: dev"
 	if err == nil || err.Error() != want {
 		t.Errorf("Error: %v, want %s", err, want)
 	}
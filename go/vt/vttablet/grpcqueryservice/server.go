@@ -375,6 +375,19 @@ func (q *query) UpdateStream(request *querypb.UpdateStreamRequest, stream querys
 	return nil
 }
 
+// UpdateStreamAck is part of the queryservice.QueryServer interface
+func (q *query) UpdateStreamAck(ctx context.Context, request *querypb.UpdateStreamAckRequest) (response *querypb.UpdateStreamAckResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	if err := q.server.UpdateStreamAck(ctx, request.Target, request.Consumer, request.Position); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.UpdateStreamAckResponse{}, nil
+}
+
 // Register registers the implementation on the provide gRPC Server.
 func Register(s *grpc.Server, server queryservice.QueryService) {
 	queryservicepb.RegisterQueryServer(s, &query{server})
@@ -668,6 +668,26 @@ func (conn *gRPCQueryClient) UpdateStream(ctx context.Context, target *querypb.T
 	}
 }
 
+// UpdateStreamAck reports the position an UpdateStream consumer has applied.
+func (conn *gRPCQueryClient) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return tabletconn.ConnClosed
+	}
+	req := &querypb.UpdateStreamAckRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Consumer:          consumer,
+		Position:          position,
+	}
+	if _, err := conn.c.UpdateStreamAck(ctx, req); err != nil {
+		return tabletconn.ErrorFromGRPC(err)
+	}
+	return nil
+}
+
 // HandlePanic is a no-op.
 func (conn *gRPCQueryClient) HandlePanic(err *error) {
 }
@@ -99,6 +99,10 @@ type QueryService interface {
 	// UpdateStream streams updates from the provided position or timestamp.
 	UpdateStream(ctx context.Context, target *querypb.Target, position string, timestamp int64, callback func(*querypb.StreamEvent) error) error
 
+	// UpdateStreamAck reports the position an UpdateStream consumer
+	// has applied.
+	UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error
+
 	// StreamHealth streams health status.
 	StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error
 
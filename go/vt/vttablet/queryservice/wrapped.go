@@ -250,6 +250,13 @@ func (ws *wrappedService) UpdateStream(ctx context.Context, target *querypb.Targ
 	})
 }
 
+func (ws *wrappedService) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	return ws.wrapper(ctx, target, ws.impl, "UpdateStreamAck", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (error, bool) {
+		innerErr := conn.UpdateStreamAck(ctx, target, consumer, position)
+		return innerErr, canRetry(ctx, innerErr)
+	})
+}
+
 func (ws *wrappedService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return ws.wrapper(ctx, nil, ws.impl, "StreamHealth", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (error, bool) {
 		innerErr := conn.StreamHealth(ctx, callback)
@@ -358,6 +358,11 @@ func (sbc *SandboxConn) UpdateStream(ctx context.Context, target *querypb.Target
 	return fmt.Errorf("Not implemented in test")
 }
 
+// UpdateStreamAck is part of the QueryService interface.
+func (sbc *SandboxConn) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	return fmt.Errorf("Not implemented in test")
+}
+
 // HandlePanic is part of the QueryService interface.
 func (sbc *SandboxConn) HandlePanic(err *error) {
 }
@@ -850,6 +850,27 @@ func (f *FakeQueryService) UpdateStream(ctx context.Context, target *querypb.Tar
 	return nil
 }
 
+// UpdateStreamConsumer is a test update stream consumer name.
+const UpdateStreamConsumer = "update stream consumer"
+
+// UpdateStreamAck is part of the queryservice.QueryService interface
+func (f *FakeQueryService) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "UpdateStreamAck", target)
+	if consumer != UpdateStreamConsumer {
+		f.t.Errorf("invalid UpdateStreamAck.consumer: got %v expected %v", consumer, UpdateStreamConsumer)
+	}
+	if position != UpdateStreamPosition {
+		f.t.Errorf("invalid UpdateStreamAck.position: got %v expected %v", position, UpdateStreamPosition)
+	}
+	return nil
+}
+
 // CreateFakeServer returns the fake server for the tests
 func CreateFakeServer(t *testing.T) *FakeQueryService {
 	return &FakeQueryService{
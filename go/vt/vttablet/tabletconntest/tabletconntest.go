@@ -919,6 +919,33 @@ func testUpdateStreamPanics(t *testing.T, conn queryservice.QueryService, f *Fak
 	})
 }
 
+func testUpdateStreamAck(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUpdateStreamAck")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	if err := conn.UpdateStreamAck(ctx, TestTarget, UpdateStreamConsumer, UpdateStreamPosition); err != nil {
+		t.Fatalf("UpdateStreamAck failed: %v", err)
+	}
+}
+
+func testUpdateStreamAckError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUpdateStreamAckError")
+	f.HasError = true
+	testErrorHelper(t, f, "UpdateStreamAck", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		return conn.UpdateStreamAck(ctx, TestTarget, UpdateStreamConsumer, UpdateStreamPosition)
+	})
+	f.HasError = false
+}
+
+func testUpdateStreamAckPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testUpdateStreamAckPanics")
+	testPanicHelper(t, f, "UpdateStreamAck", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		return conn.UpdateStreamAck(ctx, TestTarget, UpdateStreamConsumer, UpdateStreamPosition)
+	})
+}
+
 // TestSuite runs all the tests.
 // If fake.TestingGateway is set, we only test the calls that can go through
 // a gateway.
@@ -945,6 +972,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testMessageAck,
 		testSplitQuery,
 		testUpdateStream,
+		testUpdateStreamAck,
 
 		// error test cases
 		testBeginError,
@@ -969,6 +997,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testMessageAckError,
 		testSplitQueryError,
 		testUpdateStreamError,
+		testUpdateStreamAckError,
 
 		// panic test cases
 		testBeginPanics,
@@ -991,6 +1020,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testMessageAckPanics,
 		testSplitQueryPanics,
 		testUpdateStreamPanics,
+		testUpdateStreamAckPanics,
 	}
 
 	if !fake.TestingGateway {
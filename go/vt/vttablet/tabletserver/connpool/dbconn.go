@@ -56,6 +56,11 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	pool    *Pool
 	current sync2.AtomicString
+	created time.Time
+
+	// killed is set by Kill. The connection error of a killed
+	// query must not cause the query to be executed again.
+	killed sync2.AtomicBool
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -72,6 +77,7 @@ func NewDBConn(
 		info:    appParams,
 		pool:    cp,
 		dbaPool: cp.dbaPool,
+		created: time.Now(),
 	}, nil
 }
 
@@ -110,12 +116,16 @@ func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfiel
 			return nil, err
 		}
 
-		// Connection error. Retry if context has not expired.
+		// Connection error. Retry if context has not expired
+		// and the query was not killed.
 		select {
 		case <-ctx.Done():
 			return nil, err
 		default:
 		}
+		if dbc.killed.Get() {
+			return nil, err
+		}
 
 		if reconnectErr := dbc.reconnect(); reconnectErr != nil {
 			dbc.pool.checker.CheckMySQL()
@@ -150,7 +160,10 @@ func (dbc *DBConn) ExecOnce(ctx context.Context, query string, maxrows int, want
 }
 
 // Stream executes the query and streams the results.
-func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int, includedFields querypb.ExecuteOptions_IncludedFields) error {
+// Results are sent in batches of up to streamBufferSize bytes, or
+// streamBufferRows rows if it's not 0. Rows are read from MySQL only
+// as fast as the callback accepts them.
+func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize, streamBufferRows int, includedFields querypb.ExecuteOptions_IncludedFields) error {
 	span := trace.NewSpanFromContext(ctx)
 	span.StartClient("DBConn.Stream")
 	defer span.Finish()
@@ -168,6 +181,7 @@ func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqlt
 				return callback(r)
 			},
 			streamBufferSize,
+			streamBufferRows,
 		)
 		switch {
 		case err == nil:
@@ -184,12 +198,16 @@ func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqlt
 			return err
 		}
 
-		// Connection error. Retry if context has not expired.
+		// Connection error. Retry if context has not expired
+		// and the query was not killed.
 		select {
 		case <-ctx.Done():
 			return err
 		default:
 		}
+		if dbc.killed.Get() {
+			return err
+		}
 		if reconnectErr := dbc.reconnect(); reconnectErr != nil {
 			dbc.pool.checker.CheckMySQL()
 			// Return the error of the reconnect and not the original connection error.
@@ -199,7 +217,7 @@ func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqlt
 	panic("unreachable")
 }
 
-func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int) error {
+func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize, streamBufferRows int) error {
 	dbc.current.Set(query)
 	defer dbc.current.Set("")
 
@@ -210,7 +228,34 @@ func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*
 			wg.Wait()
 		}()
 	}
-	return dbc.conn.ExecuteStreamFetch(query, callback, streamBufferSize)
+
+	var sendTime time.Duration
+	var peakBytes int64
+	if dbc.pool != nil {
+		defer func(start time.Time) {
+			dbc.pool.recordStream(sendTime, time.Since(start), peakBytes)
+		}(time.Now())
+	}
+	return dbc.conn.ExecuteStreamFetch(query, func(qr *sqltypes.Result) error {
+		// Stop reading from MySQL as soon as the client is gone.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var size int64
+		for _, row := range qr.Rows {
+			for _, v := range row {
+				size += int64(v.Len())
+			}
+		}
+		if size > peakBytes {
+			peakBytes = size
+		}
+		start := time.Now()
+		defer func() {
+			sendTime += time.Since(start)
+		}()
+		return callback(qr)
+	}, streamBufferSize, streamBufferRows)
 }
 
 var (
@@ -283,11 +328,26 @@ func (dbc *DBConn) Recycle() {
 		dbc.Close()
 	case dbc.conn.IsClosed():
 		dbc.pool.Put(nil)
+	case dbc.killed.Get():
+		// MySQL closes the connection of a killed query.
+		dbc.Close()
+		dbc.pool.Put(nil)
+	case dbc.expired():
+		dbc.pool.lifetimeClosed.Add(1)
+		dbc.Close()
+		dbc.pool.Put(nil)
 	default:
 		dbc.pool.Put(dbc)
 	}
 }
 
+// expired returns true if the connection is older than the
+// max lifetime of its pool.
+func (dbc *DBConn) expired() bool {
+	maxLifetime := dbc.pool.MaxLifetime()
+	return maxLifetime > 0 && time.Now().Sub(dbc.created) > maxLifetime
+}
+
 // Kill kills the currently executing query both on MySQL side
 // and on the connection side. If no query is executing, it's a no-op.
 // Kill will also not kill a query more than once.
@@ -300,9 +360,13 @@ func (dbc *DBConn) Kill(reason string, elapsed time.Duration) error {
 		return err
 	}
 	defer killConn.Recycle()
+	// Mark the connection before killing it: the query can see
+	// its connection error before the kill returns.
+	dbc.killed.Set(true)
 	sql := fmt.Sprintf("kill %d", dbc.conn.ID())
 	_, err = killConn.ExecuteFetch(sql, 10000, false)
 	if err != nil {
+		dbc.killed.Set(false)
 		log.Errorf("Could not kill query %s: %v", dbc.Current(), err)
 		return err
 	}
@@ -326,6 +390,7 @@ func (dbc *DBConn) reconnect() error {
 		return err
 	}
 	dbc.conn = newConn
+	dbc.killed.Set(false)
 	return nil
 }
 
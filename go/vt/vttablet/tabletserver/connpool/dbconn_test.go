@@ -118,6 +118,42 @@ func TestDBConnKill(t *testing.T) {
 	}
 }
 
+func TestDBConnExecKilled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.AddQuery(fmt.Sprintf("kill %d", dbConn.ID()), &sqltypes.Result{})
+	if err := dbConn.Kill("test kill", 0); err != nil {
+		t.Fatalf("kill should succeed, but got error: %v", err)
+	}
+
+	// MySQL drops the connection of the killed query:
+	// Exec must return the error instead of running the query again.
+	db.EnableShouldClose()
+	_, err = dbConn.Exec(context.Background(), sql, 1, false)
+	want := "errno 2013"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Exec: %v, want %s", err, want)
+	}
+	if n := db.GetQueryCalledNum(sql); n != 1 {
+		t.Errorf("query executed %d times, want 1", n)
+	}
+
+	// The killed connection is not returned to the pool.
+	dbConn.Recycle()
+	if !dbConn.IsClosed() {
+		t.Error("killed connection was not closed")
+	}
+}
+
 func TestDBNoPoolConnKill(t *testing.T) {
 	db := fakesqldb.New(t)
 	connPool := newPool()
@@ -188,7 +224,7 @@ func TestDBConnStream(t *testing.T) {
 				result.Rows = append(result.Rows, r.Rows...)
 			}
 			return nil
-		}, 10, querypb.ExecuteOptions_ALL)
+		}, 10, 0, querypb.ExecuteOptions_ALL)
 	if err != nil {
 		t.Fatalf("should not get an error, err: %v", err)
 	}
@@ -201,10 +237,112 @@ func TestDBConnStream(t *testing.T) {
 	err = dbConn.Stream(
 		ctx, sql, func(r *sqltypes.Result) error {
 			return nil
-		}, 10, querypb.ExecuteOptions_ALL)
+		}, 10, 0, querypb.ExecuteOptions_ALL)
 	db.DisableConnFail()
 	want := "no such file or directory (errno 2002)"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Error: '%v', must contain '%s'", err, want)
 	}
 }
+
+func TestDBConnStreamBufferRows(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("1")},
+			{sqltypes.NewVarChar("22")},
+			{sqltypes.NewVarChar("333")},
+		},
+	})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbConn.Recycle()
+
+	var batches []int
+	err = dbConn.Stream(
+		context.Background(), sql, func(r *sqltypes.Result) error {
+			if r.Fields == nil {
+				batches = append(batches, len(r.Rows))
+			}
+			return nil
+		}, 1000, 2, querypb.ExecuteOptions_ALL)
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if want := []int{2, 1}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("batches: %v, want %v", batches, want)
+	}
+	if got, want := connPool.StreamPeakBytes(), int64(3); got != want {
+		t.Errorf("StreamPeakBytes: %d, want %d", got, want)
+	}
+}
+
+func TestDBConnStreamSendError(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("1")},
+			{sqltypes.NewVarChar("22")},
+		},
+	})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbConn.Recycle()
+
+	err = dbConn.Stream(
+		context.Background(), sql, func(r *sqltypes.Result) error {
+			if r.Fields != nil {
+				return nil
+			}
+			return errors.New("client gone")
+		}, 1, 0, querypb.ExecuteOptions_ALL)
+	if err == nil || err.Error() != "client gone" {
+		t.Errorf("Stream: %v, want client gone", err)
+	}
+	// The rest of the result is not read: the connection is closed instead.
+	if !dbConn.IsClosed() {
+		t.Error("connection is not closed after a send error")
+	}
+
+	// A cancelled context stops the stream before anything is sent.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dbConn2, err := NewDBConn(connPool, db.ConnParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbConn2.Close()
+	sent := 0
+	err = dbConn2.Stream(
+		ctx, sql, func(r *sqltypes.Result) error {
+			sent++
+			return nil
+		}, 1, 0, querypb.ExecuteOptions_ALL)
+	want := "stream send error: context canceled"
+	if err == nil || err.Error() != want {
+		t.Errorf("Stream: %v, want %s", err, want)
+	}
+	if sent != 0 {
+		t.Errorf("results sent: %d, want 0", sent)
+	}
+}
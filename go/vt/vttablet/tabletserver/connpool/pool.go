@@ -25,6 +25,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/pools"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/sync2"
 	"github.com/youtube/vitess/go/vt/callerid"
 	"github.com/youtube/vitess/go/vt/dbconnpool"
 	"github.com/youtube/vitess/go/vt/vterrors"
@@ -63,6 +64,17 @@ type Pool struct {
 	dbaPool        *dbconnpool.ConnectionPool
 	checker        MySQLChecker
 	appDebugParams *mysql.ConnParams
+
+	// maxLifetime, if non-zero, is the age after which a connection
+	// is closed instead of being returned to the pool.
+	maxLifetime    sync2.AtomicDuration
+	lifetimeClosed sync2.AtomicInt64
+
+	// streamThrottled counts the streams that spent more time waiting
+	// for the client than reading from MySQL. streamPeakBytes is the
+	// largest batch of rows held for a client.
+	streamThrottled sync2.AtomicInt64
+	streamPeakBytes sync2.AtomicInt64
 }
 
 // New creates a new Pool. The name is used
@@ -91,6 +103,10 @@ func New(
 	stats.Publish(name+"WaitTime", stats.DurationFunc(cp.WaitTime))
 	stats.Publish(name+"IdleTimeout", stats.DurationFunc(cp.IdleTimeout))
 	stats.Publish(name+"IdleClosed", stats.IntFunc(cp.IdleClosed))
+	stats.Publish(name+"MaxLifetime", stats.DurationFunc(cp.MaxLifetime))
+	stats.Publish(name+"LifetimeClosed", stats.IntFunc(cp.LifetimeClosed))
+	stats.Publish(name+"StreamThrottled", stats.IntFunc(cp.StreamThrottled))
+	stats.Publish(name+"StreamPeakBytes", stats.IntFunc(cp.StreamPeakBytes))
 	return cp
 }
 
@@ -186,6 +202,15 @@ func (cp *Pool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.idleTimeout = idleTimeout
 }
 
+// SetMaxLifetime sets the maximum age of connections in the pool.
+// Older connections are closed when they're recycled, and replaced
+// on demand. A value of 0 means connections are kept forever.
+// The setting also applies to the dba connections used for kills.
+func (cp *Pool) SetMaxLifetime(maxLifetime time.Duration) {
+	cp.maxLifetime.Set(maxLifetime)
+	cp.dbaPool.SetMaxLifetime(maxLifetime)
+}
+
 // StatsJSON returns the pool stats as a JSON object.
 func (cp *Pool) StatsJSON() string {
 	p := cp.pool()
@@ -276,6 +301,42 @@ func (cp *Pool) IdleClosed() int64 {
 	return p.IdleClosed()
 }
 
+// MaxLifetime returns the maximum age of connections in the pool.
+func (cp *Pool) MaxLifetime() time.Duration {
+	return cp.maxLifetime.Get()
+}
+
+// LifetimeClosed returns the number of connections closed because
+// they exceeded the max lifetime.
+func (cp *Pool) LifetimeClosed() int64 {
+	return cp.lifetimeClosed.Get()
+}
+
+// StreamThrottled returns the number of streams that were slowed
+// down by their client more than by MySQL.
+func (cp *Pool) StreamThrottled() int64 {
+	return cp.streamThrottled.Get()
+}
+
+// StreamPeakBytes returns the size of the largest batch of rows
+// that was held for a client.
+func (cp *Pool) StreamPeakBytes() int64 {
+	return cp.streamPeakBytes.Get()
+}
+
+// recordStream updates the stream stats of the pool.
+func (cp *Pool) recordStream(sendTime, elapsed time.Duration, peakBytes int64) {
+	if sendTime > elapsed-sendTime {
+		cp.streamThrottled.Add(1)
+	}
+	for {
+		peak := cp.streamPeakBytes.Get()
+		if peakBytes <= peak || cp.streamPeakBytes.CompareAndSwap(peak, peakBytes) {
+			return
+		}
+	}
+}
+
 func (cp *Pool) isCallerIDAppDebug(ctx context.Context) bool {
 	callerID := callerid.ImmediateCallerIDFromContext(ctx)
 	if cp.appDebugParams.Uname == "" {
@@ -129,6 +129,51 @@ func TestConnPoolSetCapacity(t *testing.T) {
 	}
 }
 
+func TestConnPoolMaxLifetime(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	dbConn.Recycle()
+	if got := connPool.LifetimeClosed(); got != 0 {
+		t.Errorf("LifetimeClosed: %d, want 0", got)
+	}
+
+	connPool.SetMaxLifetime(1 * time.Nanosecond)
+	dbConn, err = connPool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	time.Sleep(1 * time.Millisecond)
+	dbConn.Recycle()
+	if got := connPool.LifetimeClosed(); got != 1 {
+		t.Errorf("LifetimeClosed: %d, want 1", got)
+	}
+	if !dbConn.IsClosed() {
+		t.Errorf("expired connection was not closed")
+	}
+	if got, want := connPool.Available(), connPool.Capacity(); got != want {
+		t.Errorf("Available: %d, want %d", got, want)
+	}
+
+	// The dba connections used for kills expire too.
+	dbaConn, err := connPool.dbaPool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	time.Sleep(1 * time.Millisecond)
+	dbaConn.Recycle()
+	if !dbaConn.IsClosed() {
+		t.Errorf("expired dba connection was not closed")
+	}
+}
+
 func TestConnPoolStatJSON(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -60,6 +60,10 @@ type Controller interface {
 	// ReloadSchema makes the quey service reload its schema cache
 	ReloadSchema(ctx context.Context) error
 
+	// ReloadSchemaTable makes the query service reload the schema of a single table,
+	// and returns its new version
+	ReloadSchemaTable(ctx context.Context, tableName string) (int64, error)
+
 	// RegisterQueryRuleSource adds a query rule source
 	RegisterQueryRuleSource(ruleSource string)
 
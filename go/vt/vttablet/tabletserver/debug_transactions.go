@@ -0,0 +1,121 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+
+	"github.com/youtube/vitess/go/acl"
+	"github.com/youtube/vitess/go/vt/logz"
+)
+
+var (
+	transactionsHeader = []byte(`<thead>
+		<tr>
+			<th>Transaction ID</th>
+			<th>Effective Caller</th>
+			<th>Immediate Caller</th>
+			<th>Start</th>
+			<th>Duration</th>
+			<th>Idle</th>
+			<th>Queries</th>
+			<th>Kill</th>
+		</tr>
+        </thead>
+	`)
+	transactionsFuncMap = template.FuncMap{
+		"stampMicro": func(t time.Time) string { return t.Format(time.StampMicro) },
+		"since":      func(t time.Time) string { return time.Now().Sub(t).String() },
+		"join":       func(queries []string) string { return strings.Join(queries, ";\n") },
+	}
+	transactionsTmpl = template.Must(template.New("transactions").Funcs(transactionsFuncMap).Parse(`
+		<tr>
+			<td>{{.TransactionID}}</td>
+			<td>{{.EffectiveCaller}}</td>
+			<td>{{.ImmediateCaller}}</td>
+			<td>{{.StartTime | stampMicro}}</td>
+			<td>{{.StartTime | since}}</td>
+			<td>{{if .InUse}}in use{{else}}{{.LastUsed | since}}{{end}}</td>
+			<td>{{.Queries | join}}</td>
+			<td>{{if not .InUse}}<a href='/debug/transactions/kill?id={{.TransactionID}}'>Kill</a>{{end}}</td>
+		</tr>
+	`))
+)
+
+// transactionsHandler lists the open transactions of the tx pool.
+// Endpoint: /debug/transactions?format=json
+func transactionsHandler(txPool *TxPool, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	txs := txPool.ActiveTransactions()
+	if r.FormValue("format") == "json" {
+		js, err := json.Marshal(txs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(js)
+		return
+	}
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	w.Write(transactionsHeader)
+	for _, tx := range txs {
+		if err := transactionsTmpl.Execute(w, tx); err != nil {
+			log.Errorf("transactions: couldn't execute template: %v", err)
+		}
+	}
+}
+
+// transactionsKillHandler rolls back a transaction and closes its
+// connection, then lists the remaining transactions.
+// Endpoint: /debug/transactions/kill?id=<transaction id>
+func transactionsKillHandler(txPool *TxPool, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	id, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid transaction id", http.StatusBadRequest)
+		return
+	}
+	if err := txPool.Kill(id); err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+	transactionsHandler(txPool, w, r)
+}
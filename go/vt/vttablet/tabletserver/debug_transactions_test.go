@@ -0,0 +1,116 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysql/fakesqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/vterrors"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
+)
+
+func TestTransactionsHandler(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+
+	transactionID, err := txPool.Begin(context.Background(), false, querypb.ExecuteOptions_DEFAULT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txConn, err := txPool.Get(transactionID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txConn.RecordQuery("update test_table set name = 'a' where pk = 1")
+	txConn.Recycle()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/transactions?format=json", nil)
+	transactionsHandler(txPool, resp, req)
+	var txs []*TxInfo
+	if err := json.Unmarshal(resp.Body.Bytes(), &txs); err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 1 || txs[0].TransactionID != transactionID || len(txs[0].Queries) != 1 {
+		t.Errorf("transactions: %s, want transaction %d with one query", resp.Body.String(), transactionID)
+	}
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/transactions", nil)
+	transactionsHandler(txPool, resp, req)
+	if !strings.Contains(resp.Body.String(), fmt.Sprintf("/debug/transactions/kill?id=%d", transactionID)) {
+		t.Errorf("transactions page is missing the kill link: %s", resp.Body.String())
+	}
+
+	killCount := tabletenv.KillStats.Counts()["ManualTransactions"]
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/debug/transactions/kill?id=%d", transactionID), nil)
+	transactionsKillHandler(txPool, resp, req)
+	if resp.Code != http.StatusOK {
+		t.Errorf("kill: %d, want %d: %s", resp.Code, http.StatusOK, resp.Body.String())
+	}
+	if got := tabletenv.KillStats.Counts()["ManualTransactions"] - killCount; got != 1 {
+		t.Errorf("ManualTransactions kills: %d, want 1", got)
+	}
+	// The client holding the transaction gets a distinct error.
+	_, err = txPool.Get(transactionID, "for query")
+	wantErr := fmt.Sprintf("transaction %d: killed by an administrator", transactionID)
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("Get after kill: %v, want %s", err, wantErr)
+	}
+	if code := vterrors.Code(err); code != vtrpcpb.Code_CANCELED {
+		t.Errorf("Get after kill code: %v, want %v", code, vtrpcpb.Code_CANCELED)
+	}
+}
+
+func TestTransactionsKillHandlerErrors(t *testing.T) {
+	txPool := newTxPool()
+	testcases := []struct {
+		url  string
+		code int
+	}{{
+		url:  "/debug/transactions/kill?id=invalid",
+		code: http.StatusBadRequest,
+	}, {
+		url:  "/debug/transactions/kill?id=10",
+		code: http.StatusInternalServerError,
+	}}
+	for _, tcase := range testcases {
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", tcase.url, nil)
+		transactionsKillHandler(txPool, resp, req)
+		if resp.Code != tcase.code {
+			t.Errorf("%s: %d, want %d", tcase.url, resp.Code, tcase.code)
+		}
+	}
+}
@@ -60,7 +60,7 @@ type Engine struct {
 
 // NewEngine creates a new Engine.
 func NewEngine(tsv TabletService, se *schema.Engine, config tabletenv.TabletConfig) *Engine {
-	return &Engine{
+	me := &Engine{
 		tsv: tsv,
 		se:  se,
 		conns: connpool.New(
@@ -72,6 +72,8 @@ func NewEngine(tsv TabletService, se *schema.Engine, config tabletenv.TabletConf
 		postponeSema: sync2.NewSemaphore(config.MessagePostponeCap, 0),
 		managers:     make(map[string]*messageManager),
 	}
+	me.conns.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
+	return me
 }
 
 // InitDBConfig must be called before Open.
@@ -254,6 +254,16 @@ func TestEngineGenerate(t *testing.T) {
 	}
 }
 
+func TestEngineConnMaxLifetime(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.ConnMaxLifetime = 60
+	tsv := newFakeTabletServer()
+	engine := NewEngine(tsv, schema.NewEngine(tsv, config), config)
+	if got, want := engine.conns.MaxLifetime(), time.Minute; got != want {
+		t.Errorf("MaxLifetime: %v, want %v", got, want)
+	}
+}
+
 func newTestEngine(db *fakesqldb.DB) *Engine {
 	randID := rand.Int63()
 	config := tabletenv.DefaultQsConfig
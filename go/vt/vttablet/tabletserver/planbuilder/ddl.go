@@ -29,6 +29,9 @@ type DDLPlan struct {
 }
 
 // DDLParse parses a DDL and produces a DDLPlan.
+// Table option changes like ENCRYPTION or COMPRESSION rewrite the
+// tablespace but not the rows. They come back as plain alters, which
+// cause a full reload of the schema, like any other DDL.
 func DDLParse(sql string) (plan *DDLPlan) {
 	statement, err := sqlparser.Parse(sql)
 	if err != nil {
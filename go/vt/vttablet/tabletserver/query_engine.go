@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/youtube/vitess/go/acl"
 	"github.com/youtube/vitess/go/cache"
 	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/streamlog"
 	"github.com/youtube/vitess/go/sync2"
@@ -60,6 +62,10 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized *tableacl.ACLResult
 
+	// size is the estimated memory used by the plan. It's only
+	// set if the plan cache is sized by memory.
+	size int
+
 	mu         sync.Mutex
 	QueryCount int64
 	Time       time.Duration
@@ -69,8 +75,42 @@ type TabletPlan struct {
 }
 
 // Size allows TabletPlan to be in cache.LRUCache.
-func (*TabletPlan) Size() int {
-	return 1
+// If the cache is sized by memory, this is the estimated
+// size of the plan in bytes. Otherwise, every plan counts as 1.
+func (ep *TabletPlan) Size() int {
+	if ep == nil || ep.size == 0 {
+		return 1
+	}
+	return ep.size
+}
+
+// planOverhead is a rough estimate of the fixed memory used by
+// a TabletPlan, its planbuilder.Plan and the cache entry.
+const planOverhead = 512
+
+// estimateSize returns an approximation of the memory used by the
+// plan, including the query text it's cached under. Only the variable
+// length parts are accounted for, which is where plans differ.
+func (ep *TabletPlan) estimateSize(sql string) int {
+	size := planOverhead + len(sql)
+	for _, pq := range []*sqlparser.ParsedQuery{ep.FieldQuery, ep.FullQuery, ep.OuterQuery, ep.Subquery, ep.UpsertQuery, ep.WhereClause} {
+		if pq != nil {
+			size += len(pq.Query)
+		}
+	}
+	for _, field := range ep.Fields {
+		size += 64 + len(field.Name) + len(field.Table) + len(field.OrgTable) + len(field.Database) + len(field.OrgName)
+	}
+	size += planValuesSize(ep.PKValues) + planValuesSize(ep.SecondaryPKValues)
+	return size
+}
+
+func planValuesSize(pvs []sqltypes.PlanValue) int {
+	size := 0
+	for _, pv := range pvs {
+		size += 64 + len(pv.Key) + len(pv.ListKey) + pv.Value.Len() + planValuesSize(pv.Values)
+	}
+	return size
 }
 
 // AddStats updates the stats for the current TabletPlan.
@@ -115,12 +155,19 @@ type QueryEngine struct {
 	plans            *cache.LRUCache
 	queryRuleSources *rules.Map
 
+	// plansByMemory is true if the plan cache capacity is
+	// in bytes instead of number of plans.
+	plansByMemory   bool
+	largestPlanSize sync2.AtomicInt64
+
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
 
 	// Services
 	consolidator *sync2.Consolidator
+	// enableConsolidator is false if identical queries must not share results.
+	enableConsolidator bool
 	// txSerializer protects vttablet from applications which try to concurrently
 	// UPDATE (or DELETE) a "hot" row (or range of rows).
 	// Such queries would be serialized by MySQL anyway. This serializer prevents
@@ -128,6 +175,8 @@ type QueryEngine struct {
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
 	streamQList  *QueryList
+	// qList tracks the queries of Execute calls that are running on MySQL.
+	qList *QueryList
 
 	// Vars
 	binlogFormat     connpool.BinlogFormat
@@ -137,10 +186,18 @@ type QueryEngine struct {
 	maxDMLRows       sync2.AtomicInt64
 	passthroughDMLs  sync2.AtomicBool
 	allowUnsafeDMLs  bool
+	normalizeINLists bool
 	streamBufferSize sync2.AtomicInt64
+	streamBufferRows sync2.AtomicInt64
+	// consolidatorMaxResultSize is the maximum size of a result (in bytes)
+	// which will be shared by the consolidator. 0 means no limit.
+	consolidatorMaxResultSize sync2.AtomicInt64
 	// tableaclExemptCount count the number of accesses allowed
 	// based on membership in the superuser ACL
-	tableaclExemptCount  sync2.AtomicInt64
+	tableaclExemptCount sync2.AtomicInt64
+	// schemaEvictions counts the plans dropped from the cache
+	// because a table was altered or dropped.
+	schemaEvictions      sync2.AtomicInt64
 	strictTableACL       bool
 	enableTableACLDryRun bool
 	// TODO(sougou) There are two acl packages. Need to rename.
@@ -160,11 +217,16 @@ var (
 // This is a singleton class.
 // You must call this only once.
 func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tabletenv.TabletConfig) *QueryEngine {
+	cacheCapacity := config.QueryPlanCacheSize
+	if config.QueryPlanCacheMemory > 0 {
+		cacheCapacity = config.QueryPlanCacheMemory
+	}
 	qe := &QueryEngine{
 		se:               se,
 		tables:           make(map[string]*schema.Table),
-		plans:            cache.NewLRUCache(int64(config.QueryPlanCacheSize)),
+		plans:            cache.NewLRUCache(int64(cacheCapacity)),
 		queryRuleSources: rules.NewMap(),
+		plansByMemory:    config.QueryPlanCacheMemory > 0,
 	}
 
 	qe.conns = connpool.New(
@@ -179,13 +241,17 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		time.Duration(config.IdleTimeout*1e9),
 		checker,
 	)
+	qe.conns.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
+	qe.streamConns.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
 
 	qe.consolidator = sync2.NewConsolidator()
+	qe.enableConsolidator = config.EnableConsolidator
 	qe.txSerializer = txserializer.New(config.EnableHotRowProtectionDryRun,
 		config.HotRowProtectionMaxQueueSize,
 		config.HotRowProtectionMaxGlobalQueueSize,
 		config.HotRowProtectionConcurrentTransactions)
 	qe.streamQList = NewQueryList()
+	qe.qList = NewQueryList()
 
 	qe.autoCommit.Set(config.EnableAutoCommit)
 	qe.strictTableACL = config.StrictTableACL
@@ -210,8 +276,11 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 	qe.warnResultSize = sync2.NewAtomicInt64(int64(config.WarnResultSize))
 	qe.maxDMLRows = sync2.NewAtomicInt64(int64(config.MaxDMLRows))
 	qe.streamBufferSize = sync2.NewAtomicInt64(int64(config.StreamBufferSize))
+	qe.streamBufferRows = sync2.NewAtomicInt64(int64(config.StreamBufferRows))
+	qe.consolidatorMaxResultSize = sync2.NewAtomicInt64(int64(config.ConsolidatorMaxResultSize))
 
 	qe.passthroughDMLs = sync2.NewAtomicBool(config.PassthroughDMLs)
+	qe.normalizeINLists = config.NormalizeINLists
 	planbuilder.PassthroughDMLs = config.PassthroughDMLs
 
 	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
@@ -221,12 +290,16 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		stats.Publish("WarnResultSize", stats.IntFunc(qe.warnResultSize.Get))
 		stats.Publish("MaxDMLRows", stats.IntFunc(qe.maxDMLRows.Get))
 		stats.Publish("StreamBufferSize", stats.IntFunc(qe.streamBufferSize.Get))
+		stats.Publish("StreamBufferRows", stats.IntFunc(qe.streamBufferRows.Get))
+		stats.Publish("ConsolidatorMaxResultSize", stats.IntFunc(qe.consolidatorMaxResultSize.Get))
 		stats.Publish("TableACLExemptCount", stats.IntFunc(qe.tableaclExemptCount.Get))
 
 		stats.Publish("QueryCacheLength", stats.IntFunc(qe.plans.Length))
 		stats.Publish("QueryCacheSize", stats.IntFunc(qe.plans.Size))
 		stats.Publish("QueryCacheCapacity", stats.IntFunc(qe.plans.Capacity))
 		stats.Publish("QueryCacheEvictions", stats.IntFunc(qe.plans.Evictions))
+		stats.Publish("QueryCacheSchemaEvictions", stats.IntFunc(qe.schemaEvictions.Get))
+		stats.Publish("QueryCacheLargestPlanBytes", stats.IntFunc(qe.largestPlanSize.Get))
 		stats.Publish("QueryCacheOldest", stats.StringFunc(func() string {
 			return fmt.Sprintf("%v", qe.plans.Oldest())
 		}))
@@ -242,6 +315,8 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 			"/debug/query_stats",
 			"/debug/query_rules",
 			"/debug/consolidations",
+			"/debug/flush_tablet_plans",
+			"/debug/explain_plan",
 		}
 		for _, ep := range endpoints {
 			http.Handle(ep, qe)
@@ -290,6 +365,33 @@ func (qe *QueryEngine) Close() {
 	qe.conns.Close()
 }
 
+// inListRE matches the start of an IN list or subquery. Queries which don't
+// have one can't be normalized, so NormalizeINLists doesn't parse them.
+var inListRE = regexp.MustCompile(`(?i)\bin\s*\(`)
+
+// NormalizeINLists rewrites the IN clauses of sql which have a list of
+// values to use list bind vars, and adds the values to bindVars. This way,
+// queries which only differ in the number of IN values share a single plan.
+// sql is returned unchanged if the feature is disabled, the query cannot be
+// parsed or it has no such IN clause.
+// The query is parsed and rebuilt on every call, even if its plan is
+// already cached, so this is only worth it if IN lists of varying length
+// would otherwise fill up the plan cache.
+func (qe *QueryEngine) NormalizeINLists(sql string, bindVars map[string]*querypb.BindVariable) string {
+	if !qe.normalizeINLists || !inListRE.MatchString(sql) {
+		return sql
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		// GetPlan will return the error.
+		return sql
+	}
+	if !sqlparser.NormalizeINLists(stmt, bindVars, "vtin") {
+		return sql
+	}
+	return sqlparser.String(stmt)
+}
+
 // GetPlan returns the TabletPlan that for the query. Plans are cached in a cache.LRUCache.
 func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats, sql string, skipQueryPlanCache bool) (*TabletPlan, error) {
 	span := trace.NewSpanFromContext(ctx)
@@ -336,11 +438,26 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 		return plan, nil
 	}
 	if !skipQueryPlanCache {
+		size := plan.estimateSize(sql)
+		if qe.plansByMemory {
+			plan.size = size
+		}
+		qe.recordPlanSize(int64(size))
 		qe.plans.Set(sql, plan)
 	}
 	return plan, nil
 }
 
+// recordPlanSize keeps track of the largest plan added to the cache.
+func (qe *QueryEngine) recordPlanSize(size int64) {
+	for {
+		largest := qe.largestPlanSize.Get()
+		if size <= largest || qe.largestPlanSize.CompareAndSwap(largest, size) {
+			return
+		}
+	}
+}
+
 // GetStreamPlan is similar to GetPlan, but doesn't use the cache
 // and doesn't enforce a limit. It just returns the parsed query.
 func (qe *QueryEngine) GetStreamPlan(sql string) (*TabletPlan, error) {
@@ -370,6 +487,19 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	return plan, nil
 }
 
+// ExplainPlan builds the plan for sql against the current schema
+// and returns it as JSON. Unlike GetPlan, the plan is not added to
+// the query plan cache, and no field query is sent to MySQL.
+func (qe *QueryEngine) ExplainPlan(sql string) ([]byte, error) {
+	qe.mu.RLock()
+	defer qe.mu.RUnlock()
+	splan, err := planbuilder.Build(sql, qe.tables)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(splan, "", "  ")
+}
+
 // ClearQueryPlanCache should be called if query plan cache is potentially obsolete
 func (qe *QueryEngine) ClearQueryPlanCache() {
 	qe.plans.Clear()
@@ -394,8 +524,15 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	defer qe.mu.Unlock()
 	qe.tables = tables
 	if len(altered) != 0 || len(dropped) != 0 {
+		// Plans can refer to more than one table (joins, subqueries),
+		// and they cache field info. So, it's not safe to only evict
+		// the plans of the affected tables.
+		qe.schemaEvictions.Add(qe.plans.Length())
 		qe.plans.Clear()
 	}
+	for _, name := range dropped {
+		tabletenv.DeleteTableStats(name)
+	}
 }
 
 // getQuery fetches the plan and makes it the most recent.
@@ -415,6 +552,7 @@ func (qe *QueryEngine) peekQuery(sql string) *TabletPlan {
 }
 
 // SetQueryPlanCacheCap sets the query plan cache capacity.
+// If the cache is sized by memory, the capacity is in bytes.
 func (qe *QueryEngine) SetQueryPlanCacheCap(size int) {
 	if size <= 0 {
 		size = 1
@@ -503,6 +641,16 @@ func (qe *QueryEngine) ServeHTTP(response http.ResponseWriter, request *http.Req
 		qe.handleHTTPQueryRules(response, request)
 	case "/debug/consolidations":
 		qe.handleHTTPConsolidations(response, request)
+	case "/debug/flush_tablet_plans":
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		qe.ClearQueryPlanCache()
+		response.Header().Set("Content-Type", "text/plain")
+		response.Write([]byte("Query plan cache flushed\n"))
+	case "/debug/explain_plan":
+		qe.handleHTTPExplainPlan(response, request)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -525,6 +673,21 @@ func (qe *QueryEngine) handleHTTPQueryPlans(response http.ResponseWriter, reques
 	}
 }
 
+func (qe *QueryEngine) handleHTTPExplainPlan(response http.ResponseWriter, request *http.Request) {
+	sql := request.FormValue("sql")
+	if sql == "" {
+		http.Error(response, "missing sql parameter", http.StatusBadRequest)
+		return
+	}
+	b, err := qe.ExplainPlan(sql)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	response.Write(b)
+}
+
 func (qe *QueryEngine) handleHTTPQueryStats(response http.ResponseWriter, request *http.Request) {
 	keys := qe.plans.Keys()
 	response.Header().Set("Content-Type", "application/json; charset=utf-8")
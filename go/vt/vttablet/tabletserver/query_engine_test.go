@@ -18,8 +18,10 @@ package tabletserver
 
 import (
 	"expvar"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -215,6 +217,237 @@ func TestNoQueryPlanCache(t *testing.T) {
 	qe.ClearQueryPlanCache()
 }
 
+func TestNormalizeINLists(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 10*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	query := "select * from test_table_01 where pk in (1, 2)"
+	if got := qe.NormalizeINLists(query, map[string]*querypb.BindVariable{}); got != query {
+		t.Errorf("NormalizeINLists() with normalization disabled: %s, want %s", got, query)
+	}
+
+	qe.normalizeINLists = true
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	for _, values := range []string{"(1, 2)", "(1, 2, 3)"} {
+		bv := make(map[string]*querypb.BindVariable)
+		got := qe.NormalizeINLists("select * from test_table_01 where pk in "+values, bv)
+		if want := "select * from test_table_01 where pk in ::vtin1"; got != want {
+			t.Errorf("NormalizeINLists(%s): %s, want %s", values, got, want)
+		}
+		if bv["vtin1"] == nil {
+			t.Errorf("NormalizeINLists(%s): missing bind var vtin1: %v", values, bv)
+		}
+		if _, err := qe.GetPlan(ctx, logStats, got, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := qe.plans.Length(); got != 1 {
+		t.Errorf("query plan cache length: %d, want 1", got)
+	}
+
+	bv := make(map[string]*querypb.BindVariable)
+	got := qe.NormalizeINLists("select * from test_table_01 where PK IN(1, 2)", bv)
+	if want := "select * from test_table_01 where PK in ::vtin1"; got != want {
+		t.Errorf("NormalizeINLists(): %s, want %s", got, want)
+	}
+
+	// Queries without an IN list are not parsed.
+	for query, want := range map[string]bool{
+		"select * from test_table_01 where pk = 1":              false,
+		"select * from test_table_01 join (select 1) as t":      false,
+		"select * from test_table_01 where pk in (1)":           true,
+		"select * from test_table_01 where pk not IN\n(1)":      true,
+		"select * from test_table_01 where pk in (select 1)":    true,
+		"select * from test_table_01 where pk in ::vtin1 and 1": false,
+	} {
+		if got := inListRE.MatchString(query); got != want {
+			t.Errorf("inListRE.MatchString(%q): %v, want %v", query, got, want)
+		}
+	}
+
+	// Subqueries are not changed.
+	query = "select * from test_table_01 where pk in (select pk from test_table_02)"
+	if got := qe.NormalizeINLists(query, map[string]*querypb.BindVariable{}); got != query {
+		t.Errorf("NormalizeINLists(): %s, want %s", got, query)
+	}
+	qe.ClearQueryPlanCache()
+}
+
+func TestQueryPlanCacheMemory(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+
+	shortQuery := "select * from test_table_01"
+	longQuery := "select * from test_table_02 where name in ('aaaaaaaaaaaaaaaaaaaa', 'bbbbbbbbbbbbbbbbbbbb', 'cccccccccccccccccccc')"
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select * from test_table_02 where 1 != 1", &sqltypes.Result{})
+
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	config := tabletenv.DefaultQsConfig
+	config.QueryPlanCacheMemory = 1 << 20
+	se := schema.NewEngine(DummyChecker, config)
+	qe := NewQueryEngine(DummyChecker, se, config)
+	se.InitDBConfig(dbcfgs)
+	qe.InitDBConfig(dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	shortPlan, err := qe.GetPlan(ctx, logStats, shortQuery, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	longPlan, err := qe.GetPlan(ctx, logStats, longQuery, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shortPlan.Size() <= 1 || longPlan.Size() <= shortPlan.Size() {
+		t.Errorf("plan sizes: %d, %d, want increasing sizes in bytes", shortPlan.Size(), longPlan.Size())
+	}
+	if got, want := qe.plans.Size(), int64(shortPlan.Size()+longPlan.Size()); got != want {
+		t.Errorf("qe.plans.Size(): %d, want %d", got, want)
+	}
+	if got, want := qe.largestPlanSize.Get(), int64(longPlan.Size()); got != want {
+		t.Errorf("largestPlanSize: %d, want %d", got, want)
+	}
+
+	// Shrinking the cache below the size of both plans
+	// must evict the least recently used one.
+	qe.SetQueryPlanCacheCap(longPlan.Size())
+	if qe.peekQuery(shortQuery) != nil {
+		t.Errorf("plan for %s was not evicted", shortQuery)
+	}
+	if qe.peekQuery(longQuery) == nil {
+		t.Errorf("plan for %s was evicted", longQuery)
+	}
+
+	request, _ := http.NewRequest("GET", "/debug/flush_tablet_plans", nil)
+	response := httptest.NewRecorder()
+	qe.ServeHTTP(response, request)
+	if qe.plans.Length() != 0 {
+		t.Errorf("query plan cache length after flush: %d, want 0", qe.plans.Length())
+	}
+}
+
+func TestQueryPlanCacheSchemaChange(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	query := "select * from test_table_01"
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 10*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	if _, err := qe.GetPlan(ctx, logStats, query, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new table doesn't affect existing plans.
+	qe.schemaChanged(qe.tables, []string{"test_table_04"}, nil, nil)
+	if qe.peekQuery(query) == nil {
+		t.Errorf("plan for %s was evicted after create", query)
+	}
+	if got := qe.schemaEvictions.Get(); got != 0 {
+		t.Errorf("schemaEvictions: %d, want 0", got)
+	}
+
+	// Altering a table must force the plan to be rebuilt.
+	qe.schemaChanged(qe.tables, nil, []string{"test_table_01"}, nil)
+	if qe.peekQuery(query) != nil {
+		t.Errorf("plan for %s was not evicted after alter", query)
+	}
+	if got := qe.schemaEvictions.Get(); got != 1 {
+		t.Errorf("schemaEvictions: %d, want 1", got)
+	}
+	if _, err := qe.GetPlan(ctx, logStats, query, false); err != nil {
+		t.Fatal(err)
+	}
+	if qe.peekQuery(query) == nil {
+		t.Errorf("plan for %s was not rebuilt", query)
+	}
+}
+
+func TestSchemaChangeDropsTableStats(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 10*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	tabletenv.UserTableQueryCount.Add([]string{"test_table_02", "user1", "Execute"}, 1)
+	tabletenv.TableaclAllowed.Add([]string{"test_table_02", "group1", "PASS_SELECT", "user1"}, 1)
+	before := len(tabletenv.UserTableQueryCount.Counts()) + len(tabletenv.TableaclAllowed.Counts())
+
+	debugVarsSize := func() int {
+		response := httptest.NewRecorder()
+		expvar.Handler().ServeHTTP(response, httptest.NewRequest("GET", "/debug/vars", nil))
+		return response.Body.Len()
+	}
+	sizeBefore := debugVarsSize()
+
+	// Stats of tables that come and go must not accumulate.
+	for i := 0; i < 2000; i++ {
+		table := fmt.Sprintf("temp_table_%d", i)
+		tabletenv.UserTableQueryCount.Add([]string{table, "user1", "Execute"}, 1)
+		tabletenv.UserTableQueryTimesNs.Add([]string{table, "user1", "Execute"}, 10)
+		tabletenv.TableaclAllowed.Add([]string{table, "group1", "PASS_SELECT", "user1"}, 1)
+		qe.schemaChanged(qe.tables, nil, nil, []string{table})
+	}
+	if got := len(tabletenv.UserTableQueryCount.Counts()) + len(tabletenv.TableaclAllowed.Counts()); got != before {
+		t.Errorf("per-table stats after dropping tables: %d entries, want %d", got, before)
+	}
+	// Without the cleanup, the stats of 2000 tables add more than
+	// 200KB. Allow for variables that change on their own, like memstats.
+	if got := debugVarsSize() - sizeBefore; got > 10000 {
+		t.Errorf("/debug/vars grew by %d bytes after dropping 2000 tables", got)
+	}
+	for k := range tabletenv.UserTableQueryTimesNs.Counts() {
+		if strings.HasPrefix(k, "temp_table_") {
+			t.Errorf("UserTableQueryTimesNs still has %s", k)
+		}
+	}
+
+	qe.schemaChanged(qe.tables, nil, nil, []string{"test_table_02"})
+	if got, ok := tabletenv.UserTableQueryCount.Counts()["test_table_02.user1.Execute"]; ok {
+		t.Errorf("UserTableQueryCount for test_table_02: %d, want deleted", got)
+	}
+	if got, ok := tabletenv.TableaclAllowed.Counts()["test_table_02.group1.PASS_SELECT.user1"]; ok {
+		t.Errorf("TableaclAllowed for test_table_02: %d, want deleted", got)
+	}
+}
+
 func TestStatsURL(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -251,6 +484,49 @@ func TestStatsURL(t *testing.T) {
 	qe.ServeHTTP(response, request)
 }
 
+func TestExplainPlan(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 1*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	query := "select * from test_table_01"
+	b, err := qe.ExplainPlan(query)
+	if err != nil {
+		t.Fatalf("ExplainPlan(%s): %v", query, err)
+	}
+	if want := `"PlanID": "PASS_SELECT"`; !strings.Contains(string(b), want) {
+		t.Errorf("ExplainPlan(%s): %s, must contain %s", query, b, want)
+	}
+	if qe.peekQuery(query) != nil {
+		t.Errorf("ExplainPlan(%s) added the plan to the query plan cache", query)
+	}
+	if _, err := qe.ExplainPlan("select * from"); err == nil {
+		t.Error("ExplainPlan(invalid sql): nil, want error")
+	}
+
+	request, _ := http.NewRequest("GET", "/debug/explain_plan?sql="+url.QueryEscape(query), nil)
+	response := httptest.NewRecorder()
+	qe.ServeHTTP(response, request)
+	if response.Code != http.StatusOK || response.Body.String() != string(b) {
+		t.Errorf("/debug/explain_plan: %d %s, want %d %s", response.Code, response.Body.String(), http.StatusOK, b)
+	}
+
+	request, _ = http.NewRequest("GET", "/debug/explain_plan", nil)
+	response = httptest.NewRecorder()
+	qe.ServeHTTP(response, request)
+	if response.Code != http.StatusBadRequest {
+		t.Errorf("/debug/explain_plan without sql: %d, want %d", response.Code, http.StatusBadRequest)
+	}
+}
+
 func newTestQueryEngine(queryPlanCacheSize int, idleTimeout time.Duration, strict bool, dbcfgs dbconfigs.DBConfigs) *QueryEngine {
 	config := tabletenv.DefaultQsConfig
 	config.QueryPlanCacheSize = queryPlanCacheSize
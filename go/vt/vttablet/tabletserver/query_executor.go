@@ -17,6 +17,7 @@ limitations under the License.
 package tabletserver
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -89,6 +90,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	defer func(start time.Time) {
 		duration := time.Now().Sub(start)
 		tabletenv.QueryStats.Add(planName, duration)
+		tabletenv.QueryStatsBySource.Add([]string{planName, qre.logStats.FmtQuerySources()}, duration)
 		tabletenv.RecordUserQuery(qre.ctx, qre.plan.TableName(), "Execute", int64(duration))
 
 		if reply == nil {
@@ -99,6 +101,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		tabletenv.ResultStats.Add(int64(len(reply.Rows)))
+		tabletenv.ResultStatsByPlan.Get(planName).Add(int64(len(reply.Rows)))
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
@@ -322,12 +325,14 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
+	action, qr := qre.plan.Rules.GetActionRule(remoteAddr, username, qre.bindVars)
 	switch action {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		tabletenv.QueryRuleHits.Add(qr.Name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule %s: %s", qr.Name, qr.Description)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		tabletenv.QueryRuleHits.Add(qr.Name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule %s: %s", qr.Name, qr.Description)
 	}
 
 	// Skip the ACL check if the connecting user is an exempted superuser.
@@ -789,35 +794,70 @@ func (qre *QueryExecutor) getConn(pool *connpool.Pool) (*connpool.DBConn, error)
 	return nil, err
 }
 
+// errConsolidationSkipped is passed from the original query to the
+// consolidated ones if its result is too large to be shared.
+var errConsolidationSkipped = errors.New("result too large for consolidation")
+
+// qFetch executes the query outside of a transaction. Identical queries which
+// are already in flight are consolidated i.e. they wait for the original query
+// and share its result.
 func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	sql, err := qre.generateFinalSQL(parsedQuery, bindVars, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	if !qre.tsv.qe.enableConsolidator {
+		return qre.qFetchDirect(logStats, sql)
+	}
 	q, ok := qre.tsv.qe.consolidator.Create(string(sql))
 	if ok {
 		defer q.Broadcast()
-		waitingForConnectionStart := time.Now()
-		conn, err := qre.tsv.qe.conns.Get(qre.ctx)
-		logStats.WaitingForConnection += time.Now().Sub(waitingForConnectionStart)
-		if err != nil {
-			q.Err = err
-		} else {
-			defer conn.Recycle()
-			q.Result, q.Err = qre.execSQL(conn, sql, false)
+		result, err := qre.qFetchDirect(logStats, sql)
+		q.Result, q.Err = result, err
+		if maxSize := qre.tsv.qe.consolidatorMaxResultSize.Get(); err == nil && maxSize > 0 && resultSize(result) > maxSize {
+			q.Result, q.Err = nil, errConsolidationSkipped
 		}
-	} else {
-		logStats.QuerySources |= tabletenv.QuerySourceConsolidator
-		startTime := time.Now()
-		q.Wait()
-		tabletenv.WaitStats.Record("Consolidations", startTime)
+		return result, err
+	}
+	tabletenv.ConsolidatorStats.Add("Hits", 1)
+	startTime := time.Now()
+	q.Wait()
+	tabletenv.WaitStats.Record("Consolidations", startTime)
+	if q.Err == errConsolidationSkipped {
+		tabletenv.ConsolidatorStats.Add("Skipped", 1)
+		return qre.qFetchDirect(logStats, sql)
 	}
+	logStats.QuerySources |= tabletenv.QuerySourceConsolidator
 	if q.Err != nil {
 		return nil, q.Err
 	}
+	tabletenv.ConsolidatorStats.Add("Saved", 1)
 	return q.Result.(*sqltypes.Result), nil
 }
 
+// qFetchDirect executes the query on a connection of the regular pool.
+func (qre *QueryExecutor) qFetchDirect(logStats *tabletenv.LogStats, sql string) (*sqltypes.Result, error) {
+	waitingForConnectionStart := time.Now()
+	conn, err := qre.tsv.qe.conns.Get(qre.ctx)
+	logStats.WaitingForConnection += time.Now().Sub(waitingForConnectionStart)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return qre.execSQL(conn, sql, false)
+}
+
+// resultSize returns the number of bytes of all values in the result.
+func resultSize(qr *sqltypes.Result) int64 {
+	var size int64
+	for _, row := range qr.Rows {
+		for _, v := range row {
+			size += int64(v.Len())
+		}
+	}
+	return size
+}
+
 // txFetch fetches from a TxConnection.
 func (qre *QueryExecutor) txFetch(conn *TxConnection, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable, extras map[string]sqlparser.Encodable, buildStreamComment []byte, wantfields, record bool) (*sqltypes.Result, error) {
 	sql, err := qre.generateFinalSQL(parsedQuery, bindVars, extras, buildStreamComment)
@@ -882,7 +922,20 @@ type poolConn interface {
 
 func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*sqltypes.Result, error) {
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
+	if kc, ok := conn.(killable); ok {
+		qd := NewQueryDetail(qre.ctx, kc)
+		qre.tsv.qe.qList.Add(qd)
+		defer qre.tsv.qe.qList.Remove(qd)
+	}
 	res, err := conn.Exec(qre.ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if err != nil && qre.ctx.Err() == context.DeadlineExceeded {
+		// The connection was killed because the deadline expired.
+		reason := "Deadline"
+		if _, ok := qre.tsv.callerQueryTimeout(qre.ctx); ok {
+			reason = "Quota"
+		}
+		tabletenv.RecordUserQueryKill(qre.ctx, reason)
+	}
 	warnThreshold := qre.tsv.qe.warnResultSize.Get()
 	if res != nil && warnThreshold > 0 && int64(len(res.Rows)) > warnThreshold {
 		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
@@ -894,7 +947,7 @@ func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
 	start := time.Now()
-	err := conn.Stream(qre.ctx, sql, callback, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
+	err := conn.Stream(qre.ctx, sql, callback, int(qre.tsv.qe.streamBufferSize.Get()), int(qre.tsv.qe.streamBufferRows.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
 	qre.logStats.AddRewrittenSQL(sql, start)
 	if err != nil {
 		// MySQL error that isn't due to a connection issue
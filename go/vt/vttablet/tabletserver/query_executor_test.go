@@ -17,12 +17,14 @@ limitations under the License.
 package tabletserver
 
 import (
+	"expvar"
 	"fmt"
 	"io"
 	"math/rand"
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/mysql/fakesqldb"
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/vt/callerid"
 	"github.com/youtube/vitess/go/vt/callinfo"
 	"github.com/youtube/vitess/go/vt/callinfo/fakecallinfo"
@@ -1237,6 +1240,9 @@ func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	qre := newTestQueryExecutor(ctx, tsv, query, 0)
 	defer tsv.StopService()
 	checkPlanID(t, planbuilder.PlanPassSelect, qre.plan.PlanID)
+	sourceKey := "PASS_SELECT.mysql"
+	wantSourceCount := tabletenv.QueryStatsBySource.Counts()[sourceKey] + 1
+	wantResultCount := tabletenv.ResultStatsByPlan.Get("PASS_SELECT").Count() + 1
 	got, err := qre.Execute()
 	if err != nil {
 		t.Fatalf("qre.Execute() = %v, want nil", err)
@@ -1244,6 +1250,109 @@ func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	if !reflect.DeepEqual(got, want) {
 		t.Fatalf("got: %v, want: %v", got, want)
 	}
+	if got := tabletenv.QueryStatsBySource.Counts()[sourceKey]; got != wantSourceCount {
+		t.Errorf("QueryStatsBySource[%s]: %d, want %d", sourceKey, got, wantSourceCount)
+	}
+	if got := tabletenv.ResultStatsByPlan.Get("PASS_SELECT").Count(); got != wantResultCount {
+		t.Errorf("ResultStatsByPlan[PASS_SELECT]: %d, want %d", got, wantResultCount)
+	}
+	if h, ok := expvar.Get("ResultsPassSelect").(*stats.Histogram); !ok || h.Count() != wantResultCount {
+		t.Errorf("ResultsPassSelect: %v, want a histogram with %d results", expvar.Get("ResultsPassSelect"), wantResultCount)
+	}
+}
+
+func TestQueryExecutorConsolidation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		disabled      bool
+		maxResultSize int64
+		// wantCalls is the number of times the query was sent to MySQL.
+		wantCalls                        int
+		wantHits, wantSaved, wantSkipped int64
+	}{{
+		name:      "consolidated",
+		wantCalls: 1,
+		wantHits:  1,
+		wantSaved: 1,
+	}, {
+		name:          "result too large",
+		maxResultSize: 2,
+		wantCalls:     2,
+		wantHits:      1,
+		wantSkipped:   1,
+	}, {
+		name:      "disabled",
+		disabled:  true,
+		wantCalls: 2,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := setUpQueryExecutorTest(t)
+			defer db.Close()
+			query := "select * from test_table limit 1000"
+			db.AddQuery(query, &sqltypes.Result{
+				Fields: getTestTableFields(),
+				Rows: [][]sqltypes.Value{{
+					sqltypes.NewInt64(1),
+					sqltypes.NewInt64(10),
+					sqltypes.NewInt64(2),
+				}},
+			})
+			db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+				Fields: getTestTableFields(),
+			})
+			// Block the first execution until the second query is issued.
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var once sync.Once
+			db.SetBeforeFunc(query, func() {
+				once.Do(func() { close(started) })
+				<-release
+			})
+			ctx := context.Background()
+			tsv := newTestTabletServer(ctx, noFlags, db)
+			defer tsv.StopService()
+			tsv.qe.enableConsolidator = !tc.disabled
+			tsv.qe.consolidatorMaxResultSize.Set(tc.maxResultSize)
+			before := tabletenv.ConsolidatorStats.Counts()
+
+			var wg sync.WaitGroup
+			execute := func() {
+				defer wg.Done()
+				qre := newTestQueryExecutor(ctx, tsv, query, 0)
+				got, err := qre.Execute()
+				if err != nil {
+					t.Errorf("qre.Execute() = %v, want nil", err)
+					return
+				}
+				if len(got.Rows) != 1 {
+					t.Errorf("qre.Execute() returned %d rows, want 1", len(got.Rows))
+				}
+			}
+			wg.Add(2)
+			go execute()
+			<-started
+			go execute()
+			if !tc.disabled {
+				// Wait until the second query joined the first one.
+				for len(tsv.qe.consolidator.Items()) == 0 {
+					time.Sleep(1 * time.Millisecond)
+				}
+			}
+			close(release)
+			wg.Wait()
+
+			if got := db.GetQueryCalledNum(query); got != tc.wantCalls {
+				t.Errorf("query was executed %d times, want %d", got, tc.wantCalls)
+			}
+			after := tabletenv.ConsolidatorStats.Counts()
+			for name, want := range map[string]int64{"Hits": tc.wantHits, "Saved": tc.wantSaved, "Skipped": tc.wantSkipped} {
+				if got := after[name] - before[name]; got != want {
+					t.Errorf("ConsolidatorStats[%s]: %d, want %d", name, got, want)
+				}
+			}
+		})
+	}
 }
 
 func TestQueryExecutorPlanPassSelectSqlSelectLimit(t *testing.T) {
@@ -1884,11 +1993,18 @@ func TestQueryExecutorBlacklistQRFail(t *testing.T) {
 	defer tsv.StopService()
 
 	checkPlanID(t, planbuilder.PlanPassSelect, qre.plan.PlanID)
+	hits := tabletenv.QueryRuleHits.Counts()["disable update"]
 	// execute should fail because query has been blacklisted
 	_, err := qre.Execute()
 	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
 		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_INVALID_ARGUMENT)
 	}
+	if want := "disallowed due to rule disable update: disable update"; err.Error() != want {
+		t.Errorf("qre.Execute: %v, want %s", err, want)
+	}
+	if got := tabletenv.QueryRuleHits.Counts()["disable update"] - hits; got != 1 {
+		t.Errorf("QueryRuleHits: %d, want 1", got)
+	}
 }
 
 func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
@@ -1942,6 +2058,9 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
 		t.Fatalf("tsv.qe.queryRuleSources.SetRules: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
 	}
+	if want := "disallowed due to rule disable update: disable update"; err.Error() != want {
+		t.Errorf("qre.Execute: %v, want %s", err, want)
+	}
 }
 
 type executorFlags int64
@@ -1998,6 +2117,87 @@ func newTransaction(tsv *TabletServer, options *querypb.ExecuteOptions) int64 {
 	return transactionID
 }
 
+// slowConn simulates a long-running query: Exec doesn't return
+// until the context expires or the connection is killed.
+type slowConn struct {
+	testConn
+	killed chan struct{}
+}
+
+func (sc *slowConn) Kill(string, time.Duration) error {
+	close(sc.killed)
+	return nil
+}
+
+func (sc *slowConn) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	select {
+	case <-ctx.Done():
+	case <-sc.killed:
+	}
+	return nil, fmt.Errorf("query interrupted")
+}
+
+func TestQueryExecutorCallerQueryTimeout(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "slowuser"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.SetCallerQueryTimeout("slowuser", 10*time.Millisecond)
+	if got, want := tsv.queryTimeout(ctx), 10*time.Millisecond; got != want {
+		t.Errorf("queryTimeout: %v, want %v", got, want)
+	}
+
+	key := "slowuser.Quota"
+	want := tabletenv.UserQueryKills.Counts()[key] + 1
+	ctx, cancel := context.WithTimeout(ctx, tsv.queryTimeout(ctx))
+	defer cancel()
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	if _, err := qre.execSQL(&slowConn{testConn: testConn{id: 1}, killed: make(chan struct{})}, "select sleep(10)", false); err == nil {
+		t.Error("execSQL: nil, want error")
+	}
+	if got := tabletenv.UserQueryKills.Counts()[key]; got != want {
+		t.Errorf("UserQueryKills[%s]: %d, want %d", key, got, want)
+	}
+}
+
+func TestQueryExecutorTerminateCallerQueries(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "slowuser"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	key := "slowuser.Manual"
+	want := tabletenv.UserQueryKills.Counts()[key] + 1
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	conn := &slowConn{testConn: testConn{id: 1}, killed: make(chan struct{})}
+	done := make(chan error)
+	go func() {
+		_, err := qre.execSQL(conn, "select sleep(10)", false)
+		done <- err
+	}()
+	for len(tsv.qe.qList.GetQueryzRows()) == 0 {
+		runtime.Gosched()
+	}
+
+	if n := tsv.TerminateCallerQueries("otheruser"); n != 0 {
+		t.Errorf("TerminateCallerQueries(otheruser): %d, want 0", n)
+	}
+	if n := tsv.TerminateCallerQueries("slowuser"); n != 1 {
+		t.Errorf("TerminateCallerQueries(slowuser): %d, want 1", n)
+	}
+	if err := <-done; err == nil {
+		t.Error("execSQL: nil, want error")
+	}
+	if got := tabletenv.UserQueryKills.Counts()[key]; got != want {
+		t.Errorf("UserQueryKills[%s]: %d, want %d", key, got, want)
+	}
+	if rows := tsv.qe.qList.GetQueryzRows(); len(rows) != 0 {
+		t.Errorf("running queries: %v, want none", rows)
+	}
+}
+
 func newTestQueryExecutor(ctx context.Context, tsv *TabletServer, sql string, txID int64) *QueryExecutor {
 	logStats := tabletenv.NewLogStats(ctx, "TestQueryExecutor")
 	plan, err := tsv.qe.GetPlan(ctx, logStats, sql, false)
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/youtube/vitess/go/vt/callinfo"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"golang.org/x/net/context"
 )
 
@@ -33,6 +34,11 @@ type QueryDetail struct {
 	conn   killable
 	connID int64
 	start  time.Time
+
+	// killMu serializes kills with the end of the query: once removed is
+	// set, the conn may be reused by another query and must not be killed.
+	killMu  sync.Mutex
+	removed bool
 }
 
 type killable interface {
@@ -64,32 +70,76 @@ func (ql *QueryList) Add(qd *QueryDetail) {
 	ql.queryDetails[qd.connID] = qd
 }
 
-// Remove removes a QueryDetail from QueryList
+// Remove removes a QueryDetail from QueryList. If the query is being
+// killed, it waits for the kill to finish.
 func (ql *QueryList) Remove(qd *QueryDetail) {
 	ql.mu.Lock()
-	defer ql.mu.Unlock()
 	delete(ql.queryDetails, qd.connID)
+	ql.mu.Unlock()
+
+	qd.killMu.Lock()
+	qd.removed = true
+	qd.killMu.Unlock()
+}
+
+// kill kills the connection of the query, unless the query was already
+// removed from the list. It returns false if the query was removed.
+func (qd *QueryDetail) kill(reason string) bool {
+	qd.killMu.Lock()
+	defer qd.killMu.Unlock()
+	if qd.removed {
+		return false
+	}
+	qd.conn.Kill(reason, time.Since(qd.start))
+	return true
 }
 
 // Terminate updates the query status and kills the connection
 func (ql *QueryList) Terminate(connID int64) error {
 	ql.mu.Lock()
-	defer ql.mu.Unlock()
 	qd := ql.queryDetails[connID]
+	ql.mu.Unlock()
 	if qd == nil {
 		return fmt.Errorf("query %v not found", connID)
 	}
-	qd.conn.Kill("QueryList.Terminate()", time.Since(qd.start))
+	qd.kill("QueryList.Terminate()")
 	return nil
 }
 
 // TerminateAll terminates all queries and kills the MySQL connections
 func (ql *QueryList) TerminateAll() {
+	for _, qd := range ql.list(func(*QueryDetail) bool { return true }) {
+		qd.kill("QueryList.TerminateAll()")
+	}
+}
+
+// TerminateCaller kills the MySQL connections of all the queries
+// of the given caller, and returns the number of queries killed.
+func (ql *QueryList) TerminateCaller(username string) int {
+	killed := 0
+	for _, qd := range ql.list(func(qd *QueryDetail) bool { return tabletenv.CallerName(qd.ctx) == username }) {
+		if !qd.kill("QueryList.TerminateCaller()") {
+			continue
+		}
+		tabletenv.RecordUserQueryKill(qd.ctx, "Manual")
+		killed++
+	}
+	return killed
+}
+
+// list returns the queries which match the filter. Killing a connection
+// needs a connection from the dba pool, so it must be done after
+// releasing the lock, which Add and Remove need for every query.
+func (ql *QueryList) list(filter func(*QueryDetail) bool) []*QueryDetail {
 	ql.mu.Lock()
 	defer ql.mu.Unlock()
+	var qds []*QueryDetail
 	for _, qd := range ql.queryDetails {
-		qd.conn.Kill("QueryList.TerminateAll()", time.Since(qd.start))
+		if filter(qd) {
+			qds = append(qds, qd)
+		}
 	}
+	return qds
 }
 
 // QueryDetailzRow is used for rendering QueryDetail in a template
@@ -21,6 +21,10 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/callerid"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
 
 type testConn struct {
@@ -66,3 +70,39 @@ func TestQueryList(t *testing.T) {
 		t.Errorf("failed to remove from QueryList")
 	}
 }
+
+func TestQueryListTerminateCaller(t *testing.T) {
+	ql := NewQueryList()
+	ctx1 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	ctx2 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	conns := []*testConn{{id: 1}, {id: 2}, {id: 3}}
+	ql.Add(NewQueryDetail(ctx1, conns[0]))
+	ql.Add(NewQueryDetail(ctx2, conns[1]))
+	ql.Add(NewQueryDetail(ctx1, conns[2]))
+
+	if n := ql.TerminateCaller("u1"); n != 2 {
+		t.Errorf("TerminateCaller(u1): %d, want 2", n)
+	}
+	for i, want := range []bool{true, false, true} {
+		if conns[i].IsKilled() != want {
+			t.Errorf("conn %d killed: %v, want %v", conns[i].id, conns[i].IsKilled(), want)
+		}
+	}
+}
+
+func TestQueryListTerminateRemoved(t *testing.T) {
+	ql := NewQueryList()
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	conn := &testConn{id: 1}
+	qd := NewQueryDetail(ctx, conn)
+	ql.Add(qd)
+	ql.Remove(qd)
+
+	// The conn may already run another query: it must not be killed.
+	if qd.kill("test") {
+		t.Error("kill: true, want false")
+	}
+	if conn.IsKilled() {
+		t.Error("conn of a removed query was killed")
+	}
+}
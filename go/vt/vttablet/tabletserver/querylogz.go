@@ -60,6 +60,7 @@ var (
 		"stampMicro":    func(t time.Time) string { return t.Format(time.StampMicro) },
 		"cssWrappable":  logz.Wrappable,
 		"truncateQuery": sqlparser.TruncateForUI,
+		"redactQuery":   tabletenv.RedactSQL,
 		"unquote":       func(s string) string { return strings.Trim(s, "\"") },
 	}
 	querylogzTmpl = template.Must(template.New("example").Funcs(querylogzFuncMap).Parse(`
@@ -74,7 +75,7 @@ var (
 			<td>{{.MysqlResponseTime.Seconds}}</td>
 			<td>{{.WaitingForConnection.Seconds}}</td>
 			<td>{{.PlanType}}</td>
-			<td>{{.OriginalSQL | truncateQuery | unquote | cssWrappable}}</td>
+			<td>{{.OriginalSQL | redactQuery | truncateQuery | unquote | cssWrappable}}</td>
 			<td>{{.NumberOfQueries}}</td>
 			<td>{{.FmtQuerySources}}</td>
 			<td>{{.RowsAffected}}</td>
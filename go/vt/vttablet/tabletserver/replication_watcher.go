@@ -17,6 +17,8 @@ limitations under the License.
 package tabletserver
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,14 +27,19 @@ import (
 
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/sync2"
 	"github.com/youtube/vitess/go/vt/binlog"
 	"github.com/youtube/vitess/go/vt/binlog/eventtoken"
 	"github.com/youtube/vitess/go/vt/dbconfigs"
+	"github.com/youtube/vitess/go/vt/dbconnpool"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/schema"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
 // ReplicationWatcher is a tabletserver service that watches the
@@ -47,19 +54,50 @@ type ReplicationWatcher struct {
 	wg     sync.WaitGroup
 
 	watchReplication bool
+	stallTimeout     time.Duration
 	se               *schema.Engine
 
 	mu         sync.Mutex
 	eventToken *querypb.EventToken
+	// tokenChanged is closed and replaced every time
+	// eventToken changes, to wake up WaitForPosition.
+	tokenChanged chan struct{}
+
+	// masterResets counts the times the replication position went
+	// backwards, which happens after a RESET MASTER.
+	masterResets sync2.AtomicInt64
+
+	// lastTransaction is the time (in UnixNano) the last transaction
+	// was received, or the service was opened. It's 0 while closed.
+	lastTransaction sync2.AtomicInt64
 }
 
 var replOnce sync.Once
 
+// replTimings records the time spent processing each transaction of
+// the replication stream, and separately, the time spent in the
+// schema reloads they trigger.
+var replTimings = stats.NewTimings("ReplicationWatcherTimings")
+
+// replRates computes the rate of each of the replTimings categories.
+// The "Transaction" rate is how fast the replication position advances.
+var replRates = stats.NewRates("ReplicationWatcherRates", replTimings, 15*60/5, 5*time.Second)
+
+// The binlog streamer is restarted every few seconds if it fails, which
+// would repeat the same messages indefinitely if mysqld is unreachable.
+// Those messages are throttled. Failures are still counted in InternalErrors.
+var (
+	logStreamerRestart = logutil.NewThrottledLogger("ReplicationWatcherRestart", 1*time.Minute)
+	logStreamerError   = logutil.NewThrottledLogger("ReplicationWatcherError", 1*time.Minute)
+)
+
 // NewReplicationWatcher creates a new ReplicationWatcher.
 func NewReplicationWatcher(se *schema.Engine, config tabletenv.TabletConfig) *ReplicationWatcher {
 	rpw := &ReplicationWatcher{
 		watchReplication: config.WatchReplication,
+		stallTimeout:     time.Duration(config.ReplicationStallTimeout * 1e9),
 		se:               se,
+		tokenChanged:     make(chan struct{}),
 	}
 	replOnce.Do(func() {
 		stats.Publish("EventTokenPosition", stats.StringFunc(func() string {
@@ -74,6 +112,13 @@ func NewReplicationWatcher(se *schema.Engine, config tabletenv.TabletConfig) *Re
 			}
 			return 0
 		}))
+		stats.Publish("MasterResetDetected", stats.IntFunc(rpw.masterResets.Get))
+		stats.Publish("ReplicationWatcherStalled", stats.IntFunc(func() int64 {
+			if rpw.Stalled() {
+				return 1
+			}
+			return 0
+		}))
 	})
 	return rpw
 }
@@ -90,6 +135,7 @@ func (rpw *ReplicationWatcher) Open() {
 	}
 	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
 	rpw.cancel = cancel
+	rpw.lastTransaction.Set(time.Now().UnixNano())
 	rpw.wg.Add(1)
 	go rpw.Process(ctx, rpw.dbconfigs)
 	rpw.isOpen = true
@@ -102,6 +148,7 @@ func (rpw *ReplicationWatcher) Close() {
 	}
 	rpw.cancel()
 	rpw.wg.Wait()
+	rpw.lastTransaction.Set(0)
 	rpw.isOpen = false
 }
 
@@ -112,31 +159,7 @@ func (rpw *ReplicationWatcher) Process(ctx context.Context, dbconfigs dbconfigs.
 		rpw.wg.Done()
 	}()
 	for {
-		log.Infof("Starting a binlog Streamer from current replication position to monitor binlogs")
-		cp := dbconfigs.Dba
-		cp.DbName = dbconfigs.App.DbName
-		streamer := binlog.NewStreamer(&cp, rpw.se, nil /*clientCharset*/, mysql.Position{}, 0 /*timestamp*/, func(eventToken *querypb.EventToken, statements []binlog.FullBinlogStatement) error {
-			// Save the event token.
-			rpw.mu.Lock()
-			rpw.eventToken = eventToken
-			rpw.mu.Unlock()
-
-			// If it's a DDL, trigger a schema reload.
-			for _, statement := range statements {
-				if statement.Statement.Category != binlogdatapb.BinlogTransaction_Statement_BL_DDL {
-					continue
-				}
-				err := rpw.se.Reload(ctx)
-				log.Infof("Streamer triggered a schema reload, with result: %v", err)
-				return nil
-			}
-
-			return nil
-		})
-
-		if err := streamer.Stream(ctx); err != nil {
-			log.Infof("Streamer stopped: %v", err)
-		}
+		rpw.stream(ctx, dbconfigs)
 
 		select {
 		case <-ctx.Done():
@@ -146,6 +169,148 @@ func (rpw *ReplicationWatcher) Process(ctx context.Context, dbconfigs dbconfigs.
 	}
 }
 
+// stream runs one binlog Streamer, from the current replication
+// position until it fails or ctx is done.
+func (rpw *ReplicationWatcher) stream(ctx context.Context, dbconfigs dbconfigs.DBConfigs) {
+	logStreamerRestart.Infof("Starting a binlog Streamer from current replication position to monitor binlogs")
+	cp := dbconfigs.Dba
+	cp.DbName = dbconfigs.App.DbName
+	if !checkBinlogSettings(&cp) {
+		return
+	}
+	streamer := binlog.NewStreamer(&cp, rpw.se, nil /*clientCharset*/, mysql.Position{}, 0 /*timestamp*/, func(eventToken *querypb.EventToken, statements []binlog.FullBinlogStatement) error {
+		rpw.processTransaction(ctx, eventToken, statements)
+		return nil
+	})
+
+	if err := streamer.Stream(ctx); err != nil && ctx.Err() == nil {
+		tabletenv.InternalErrors.Add("ReplicationWatcher", 1)
+		logStreamerError.Infof("Streamer stopped: %v", err)
+	}
+}
+
+// processTransaction saves the event token of a transaction, and
+// reloads the schema if the transaction contains a DDL.
+func (rpw *ReplicationWatcher) processTransaction(ctx context.Context, eventToken *querypb.EventToken, statements []binlog.FullBinlogStatement) {
+	defer replTimings.Record("Transaction", time.Now())
+	rpw.lastTransaction.Set(time.Now().UnixNano())
+
+	// Save the event token.
+	rpw.mu.Lock()
+	prev := rpw.eventToken
+	rpw.eventToken = eventToken
+	if rpw.tokenChanged != nil {
+		close(rpw.tokenChanged)
+	}
+	rpw.tokenChanged = make(chan struct{})
+	rpw.mu.Unlock()
+
+	// If the position doesn't include the previous one, the
+	// binlogs were reset. DDLs may have been missed in between.
+	if prev != nil && positionWentBack(prev.Position, eventToken.Position) {
+		rpw.masterResets.Add(1)
+		err := rpw.reloadSchema(ctx)
+		log.Warningf("Replication position went from %v to %v, possibly due to RESET MASTER. Reloaded schema with result: %v", prev.Position, eventToken.Position, err)
+	}
+
+	// If it's a DDL, trigger a schema reload.
+	for _, statement := range statements {
+		if statement.Statement.Category != binlogdatapb.BinlogTransaction_Statement_BL_DDL {
+			continue
+		}
+		err := rpw.reloadSchema(ctx)
+		log.Infof("Streamer triggered a schema reload, with result: %v", err)
+		return
+	}
+}
+
+func (rpw *ReplicationWatcher) reloadSchema(ctx context.Context) error {
+	defer replTimings.Record("SchemaReload", time.Now())
+	return rpw.se.Reload(ctx)
+}
+
+// positionWentBack returns true if cur does not contain prev. Positions
+// that can't be decoded are ignored.
+func positionWentBack(prev, cur string) bool {
+	prevPos, err := mysql.DecodePosition(prev)
+	if err != nil || prevPos.IsZero() {
+		return false
+	}
+	curPos, err := mysql.DecodePosition(cur)
+	if err != nil || curPos.IsZero() {
+		return false
+	}
+	return !curPos.AtLeast(prevPos)
+}
+
+// checkBinlogSettings logs the binlog settings which prevent the
+// replication stream from being watched reliably. It returns false if
+// there is no stream to watch. If the settings can't be read, the
+// stream is watched anyway.
+func checkBinlogSettings(cp *mysql.ConnParams) bool {
+	conn, err := dbconnpool.NewDBConnection(cp, tabletenv.MySQLStats)
+	if err != nil {
+		logStreamerError.Warningf("Could not check the binlog settings: %v", err)
+		return true
+	}
+	defer conn.Close()
+	settings, err := readBinlogSettings(conn)
+	if err != nil {
+		logStreamerError.Warningf("Could not check the binlog settings: %v", err)
+		return true
+	}
+	if !settings.enabled {
+		tabletenv.InternalErrors.Add("ReplicationWatcher", 1)
+		logStreamerError.Errorf("Binary logging is not enabled on mysqld (log_bin=OFF), the replication stream can't be watched")
+		return false
+	}
+	if settings.errorsIgnored {
+		logStreamerError.Warningf("binlog_error_action is IGNORE_ERROR: MySQL keeps running after failing to write to the binlog, so the replication stream may be missing changes")
+	}
+	return true
+}
+
+// binlogSettings are the MySQL variables which decide whether the
+// replication stream can be watched reliably.
+type binlogSettings struct {
+	// enabled is false if MySQL reports log_bin=OFF. Without
+	// binary logging, there is no replication stream to watch.
+	enabled bool
+	// errorsIgnored is true if MySQL is configured with
+	// binlog_error_action=IGNORE_ERROR. In that mode, a failed binlog
+	// write disables binary logging instead of aborting the server,
+	// and the changes that follow never make it to the replication
+	// stream. Versions of MySQL that predate the variable abort on
+	// such errors.
+	errorsIgnored bool
+}
+
+// readBinlogSettings reads the binlogSettings with a single query.
+func readBinlogSettings(conn *dbconnpool.DBConnection) (binlogSettings, error) {
+	qr, err := conn.ExecuteFetch("show variables where variable_name in ('log_bin', 'binlog_error_action')", 10, false)
+	if err != nil {
+		return binlogSettings{}, err
+	}
+	var settings binlogSettings
+	foundLogBin := false
+	for _, row := range qr.Rows {
+		if len(row) != 2 {
+			return binlogSettings{}, fmt.Errorf("unexpected result for binlog settings: %v", qr.Rows)
+		}
+		switch strings.ToLower(row[0].ToString()) {
+		case "log_bin":
+			settings.enabled = mysql.IsBinlogEnabled(row[1].ToString())
+			foundLogBin = true
+		case "binlog_error_action":
+			settings.errorsIgnored = strings.EqualFold(row[1].ToString(), "IGNORE_ERROR")
+		}
+	}
+	if !foundLogBin {
+		return binlogSettings{}, fmt.Errorf("unexpected result for log_bin: %v", qr.Rows)
+	}
+	return settings, nil
+}
+
 // ComputeExtras returns the requested ResultExtras based on the supplied options.
 func (rpw *ReplicationWatcher) ComputeExtras(options *querypb.ExecuteOptions) *querypb.ResultExtras {
 	if options == nil {
@@ -189,9 +354,54 @@ func (rpw *ReplicationWatcher) ComputeExtras(options *querypb.ExecuteOptions) *q
 	return extras
 }
 
+// Stalled returns true if the service is open, and hasn't received
+// a transaction for longer than -watch_replication_stall_timeout.
+// Without regular writes on the master (see -heartbeat_enable),
+// an idle master looks the same as a stuck stream.
+func (rpw *ReplicationWatcher) Stalled() bool {
+	last := rpw.lastTransaction.Get()
+	if rpw.stallTimeout == 0 || last == 0 {
+		return false
+	}
+	return time.Since(time.Unix(0, last)) > rpw.stallTimeout
+}
+
 // EventToken returns the current event token.
 func (rpw *ReplicationWatcher) EventToken() *querypb.EventToken {
 	rpw.mu.Lock()
 	defer rpw.mu.Unlock()
 	return rpw.eventToken
 }
+
+// WaitForPosition waits until the replication stream has reached
+// the specified position, or the context expires. Since the stream
+// is read from the local mysqld, this means the changes up to that
+// position can be read from this tablet.
+func (rpw *ReplicationWatcher) WaitForPosition(ctx context.Context, pos string) error {
+	if !rpw.watchReplication {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "replication is not being watched, enable -watch_replication_stream")
+	}
+	target, err := mysql.DecodePosition(pos)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid position %v: %v", pos, err)
+	}
+	defer replTimings.Record("WaitForPosition", time.Now())
+	for {
+		rpw.mu.Lock()
+		if rpw.tokenChanged == nil {
+			rpw.tokenChanged = make(chan struct{})
+		}
+		eventToken, tokenChanged := rpw.eventToken, rpw.tokenChanged
+		rpw.mu.Unlock()
+		if eventToken != nil {
+			if current, err := mysql.DecodePosition(eventToken.Position); err == nil && current.AtLeast(target) {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "timed out waiting for position %v", pos)
+		case <-tokenChanged:
+		}
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysql/fakesqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/binlog"
+	"github.com/youtube/vitess/go/vt/dbconnpool"
+	"github.com/youtube/vitess/go/vt/vterrors"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
+)
+
+func TestReadBinlogSettings(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	conn, err := dbconnpool.NewDBConnection(db.ConnParams(), tabletenv.MySQLStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	query := "show variables where variable_name in ('log_bin', 'binlog_error_action')"
+	fields := []*querypb.Field{{Name: "Variable_name", Type: sqltypes.VarChar}, {Name: "Value", Type: sqltypes.VarChar}}
+	row := func(name, value string) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewVarChar(name), sqltypes.NewVarChar(value)}
+	}
+	testcases := []struct {
+		rows [][]sqltypes.Value
+		want binlogSettings
+		err  bool
+	}{{
+		rows: [][]sqltypes.Value{row("binlog_error_action", "ABORT_SERVER"), row("log_bin", "ON")},
+		want: binlogSettings{enabled: true},
+	}, {
+		rows: [][]sqltypes.Value{row("binlog_error_action", "IGNORE_ERROR"), row("log_bin", "1")},
+		want: binlogSettings{enabled: true, errorsIgnored: true},
+	}, {
+		rows: [][]sqltypes.Value{row("binlog_error_action", "ABORT_SERVER"), row("log_bin", "OFF")},
+		want: binlogSettings{},
+	}, {
+		// MySQL versions without binlog_error_action.
+		rows: [][]sqltypes.Value{row("log_bin", "ON")},
+		want: binlogSettings{enabled: true},
+	}, {
+		rows: nil,
+		err:  true,
+	}}
+	for _, tcase := range testcases {
+		db.AddQuery(query, &sqltypes.Result{
+			Fields: fields,
+			Rows:   tcase.rows,
+		})
+		got, err := readBinlogSettings(conn)
+		if (err != nil) != tcase.err {
+			t.Errorf("readBinlogSettings(%v): %v, want error: %v", tcase.rows, err, tcase.err)
+			continue
+		}
+		if got != tcase.want {
+			t.Errorf("readBinlogSettings(%v): %+v, want %+v", tcase.rows, got, tcase.want)
+		}
+	}
+
+	db.AddRejectedQuery(query, fmt.Errorf("forced failure"))
+	if _, err := readBinlogSettings(conn); err == nil {
+		t.Errorf("readBinlogSettings: nil error, want forced failure")
+	}
+}
+
+func TestCheckBinlogSettings(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	fields := []*querypb.Field{{Name: "Variable_name", Type: sqltypes.VarChar}, {Name: "Value", Type: sqltypes.VarChar}}
+	for _, value := range []string{"ON", "OFF"} {
+		db.AddQuery("show variables where variable_name in ('log_bin', 'binlog_error_action')", &sqltypes.Result{
+			Fields: fields,
+			Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar("log_bin"), sqltypes.NewVarChar(value)}},
+		})
+		if got, want := checkBinlogSettings(db.ConnParams()), value == "ON"; got != want {
+			t.Errorf("checkBinlogSettings with log_bin=%s: %v, want %v", value, got, want)
+		}
+	}
+
+	// The stream is watched if the settings can't be read.
+	db.EnableConnFail()
+	defer db.DisableConnFail()
+	if !checkBinlogSettings(db.ConnParams()) {
+		t.Errorf("checkBinlogSettings without connection: false, want true")
+	}
+}
+
+func TestPositionWentBack(t *testing.T) {
+	testcases := []struct {
+		prev, cur string
+		want      bool
+	}{{
+		prev: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		cur:  "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-6",
+		want: false,
+	}, {
+		prev: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		cur:  "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		want: false,
+	}, {
+		// RESET MASTER
+		prev: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		cur:  "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1",
+		want: true,
+	}, {
+		prev: "",
+		cur:  "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1",
+		want: false,
+	}, {
+		prev: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+		cur:  "bad position",
+		want: false,
+	}}
+	for _, tcase := range testcases {
+		if got := positionWentBack(tcase.prev, tcase.cur); got != tcase.want {
+			t.Errorf("positionWentBack(%q, %q): %v, want %v", tcase.prev, tcase.cur, got, tcase.want)
+		}
+	}
+}
+
+func TestProcessTransaction(t *testing.T) {
+	rpw := NewReplicationWatcher(nil, tabletenv.DefaultQsConfig)
+	count := replTimings.Counts()["Transaction"]
+	eventToken := &querypb.EventToken{
+		Timestamp: 10,
+		Position:  "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+	}
+	statements := []binlog.FullBinlogStatement{{
+		Statement: &binlogdatapb.BinlogTransaction_Statement{
+			Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+			Sql:      []byte("insert into t values (1)"),
+		},
+	}}
+	rpw.processTransaction(context.Background(), eventToken, statements)
+	if got := rpw.EventToken(); got != eventToken {
+		t.Errorf("EventToken: %v, want %v", got, eventToken)
+	}
+	if got := replTimings.Counts()["Transaction"] - count; got != 1 {
+		t.Errorf("Transaction timings: %d, want 1", got)
+	}
+
+	// A zero value watcher must not panic.
+	rpw = &ReplicationWatcher{}
+	rpw.processTransaction(context.Background(), eventToken, statements)
+	if got := rpw.EventToken(); got != eventToken {
+		t.Errorf("EventToken: %v, want %v", got, eventToken)
+	}
+}
+
+func TestWaitForPosition(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.WatchReplication = true
+	rpw := NewReplicationWatcher(nil, config)
+	ctx := context.Background()
+	pos := "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"
+
+	done := make(chan error)
+	go func() {
+		done <- rpw.WaitForPosition(ctx, pos)
+	}()
+	rpw.processTransaction(ctx, &querypb.EventToken{Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-4"}, nil)
+	select {
+	case err := <-done:
+		t.Fatalf("WaitForPosition returned before reaching the position: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	rpw.processTransaction(ctx, &querypb.EventToken{Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-6"}, nil)
+	if err := <-done; err != nil {
+		t.Errorf("WaitForPosition: %v", err)
+	}
+
+	// Already reached.
+	if err := rpw.WaitForPosition(ctx, pos); err != nil {
+		t.Errorf("WaitForPosition: %v", err)
+	}
+
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := rpw.WaitForPosition(shortCtx, "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10")
+	if code := vterrors.Code(err); code != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("WaitForPosition: %v, want DEADLINE_EXCEEDED", err)
+	}
+
+	err = rpw.WaitForPosition(ctx, "bad position")
+	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("WaitForPosition: %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestReplicationWatcherStalled(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.ReplicationStallTimeout = 1
+	rpw := NewReplicationWatcher(nil, config)
+
+	// Not open.
+	if rpw.Stalled() {
+		t.Errorf("Stalled: true, want false")
+	}
+
+	rpw.lastTransaction.Set(time.Now().Add(-2 * time.Second).UnixNano())
+	if !rpw.Stalled() {
+		t.Errorf("Stalled: false, want true")
+	}
+
+	rpw.processTransaction(context.Background(), &querypb.EventToken{Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"}, nil)
+	if rpw.Stalled() {
+		t.Errorf("Stalled after a transaction: true, want false")
+	}
+
+	// Disabled.
+	rpw.stallTimeout = 0
+	rpw.lastTransaction.Set(time.Now().Add(-2 * time.Second).UnixNano())
+	if rpw.Stalled() {
+		t.Errorf("Stalled with no timeout: true, want false")
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"strconv"
 
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
@@ -31,6 +32,10 @@ import (
 	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
+// bindVarMismatches counts bind var conditions that could not be
+// evaluated because the bind variable had the wrong type, by operand type.
+var bindVarMismatches = stats.NewCounters("QueryRuleBindVarMismatches")
+
 //-----------------------------------------------
 
 // Rules is used to store and execute rules for the tabletserver.
@@ -143,12 +148,23 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	action, qr := qrs.GetActionRule(ip, user, bindVars)
+	if qr == nil {
+		return action, ""
+	}
+	return action, qr.Description
+}
+
+// GetActionRule is like GetAction, but it returns the rule that
+// triggered the action instead of its description. The rule is nil
+// if the action is QRContinue.
+func (qrs *Rules) GetActionRule(ip, user string, bindVars map[string]*querypb.BindVariable) (Action, *Rule) {
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+			return act, qr
 		}
 	}
-	return QRContinue, ""
+	return QRContinue, nil
 }
 
 //-----------------------------------------------
@@ -307,12 +323,15 @@ func makeExact(pattern string) string {
 // For inequalities, the bindvar is the left operand and the value
 // in the condition is the right operand: bindVar Operator value.
 // Value & operator rules
-// Type     Operators                              Bindvar
-// nil      ""                                     any type
-// uint64   ==, !=, <, >=, >, <=                   whole numbers
-// int64    ==, !=, <, >=, >, <=                   whole numbers
-// string   ==, !=, <, >=, >, <=, MATCH, NOMATCH   []byte, string
+// Type        Operators                              Bindvar
+// nil         ""                                     any type
+// uint64      ==, !=, <, >=, >, <=                   whole numbers
+// int64       ==, !=, <, >=, >, <=                   whole numbers
+// float64     ==, !=, <, >=, >, <=                   numbers
+// ListLength  ==, !=, <, >=, >, <=                   tuples
+// string      ==, !=, <, >=, >, <=, MATCH, NOMATCH   []byte, string
 // whole numbers can be: int, int8, int16, int32, int64, uint64
+// A range can be expressed as two conditions on the same bind variable.
 func (qr *Rule) AddBindVarCond(name string, onAbsent, onMismatch bool, op Operator, value interface{}) error {
 	var converted bvcValue
 	if op == QRNoOp {
@@ -330,6 +349,16 @@ func (qr *Rule) AddBindVarCond(name string, onAbsent, onMismatch bool, op Operat
 			goto Error
 		}
 		converted = bvcint64(v)
+	case float64:
+		if op < QREqual || op > QRLessEqual {
+			goto Error
+		}
+		converted = bvcfloat64(v)
+	case ListLength:
+		if op < QREqual || op > QRLessEqual {
+			goto Error
+		}
+		converted = bvclength(v)
 	case string:
 		if op >= QREqual && op <= QRLessEqual {
 			converted = bvcstring(v)
@@ -476,6 +505,9 @@ func (bvc BindVarCond) MarshalJSON() ([]byte, error) {
 	if bvc.op != QRNoOp {
 		safeEncode(b, `,"OnMismatch":`, bvc.onMismatch)
 	}
+	if _, ok := bvc.value.(bvclength); ok {
+		safeEncode(b, `,"Length":`, true)
+	}
 	safeEncode(b, `,"Operator":`, bvc.op)
 	if bvc.op != QRNoOp {
 		safeEncode(b, `,"Value":`, bvc.value)
@@ -648,11 +680,75 @@ func (ival bvcint64) eval(bv *querypb.BindVariable, op Operator, onMismatch bool
 	return onMismatch
 }
 
+type bvcfloat64 float64
+
+func (fval bvcfloat64) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
+	num, status := getfloat64(bv)
+	if status != QROK {
+		return onMismatch
+	}
+	switch op {
+	case QREqual:
+		return num == float64(fval)
+	case QRNotEqual:
+		return num != float64(fval)
+	case QRLessThan:
+		return num < float64(fval)
+	case QRGreaterEqual:
+		return num >= float64(fval)
+	case QRGreaterThan:
+		return num > float64(fval)
+	case QRLessEqual:
+		return num <= float64(fval)
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON marshals to JSON. The value always carries a
+// decimal point so that it's read back as a float64.
+func (fval bvcfloat64) MarshalJSON() ([]byte, error) {
+	b := strconv.AppendFloat(nil, float64(fval), 'f', -1, 64)
+	if bytes.IndexByte(b, '.') == -1 {
+		b = append(b, ".0"...)
+	}
+	return b, nil
+}
+
+// ListLength is a condition value that is compared against
+// the number of elements of a tuple bind variable.
+type ListLength int64
+
+type bvclength int64
+
+func (lval bvclength) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
+	if bv.Type != querypb.Type_TUPLE {
+		bindVarMismatches.Add("Length", 1)
+		return onMismatch
+	}
+	num := int64(len(bv.Values))
+	switch op {
+	case QREqual:
+		return num == int64(lval)
+	case QRNotEqual:
+		return num != int64(lval)
+	case QRLessThan:
+		return num < int64(lval)
+	case QRGreaterEqual:
+		return num >= int64(lval)
+	case QRGreaterThan:
+		return num > int64(lval)
+	case QRLessEqual:
+		return num <= int64(lval)
+	}
+	panic("unreachable")
+}
+
 type bvcstring string
 
 func (sval bvcstring) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
 	str, status := getstring(bv)
 	if status != QROK {
+		bindVarMismatches.Add("String", 1)
 		return onMismatch
 	}
 	switch op {
@@ -679,6 +775,7 @@ type bvcre struct {
 func (reval bvcre) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
 	str, status := getstring(bv)
 	if status != QROK {
+		bindVarMismatches.Add("String", 1)
 		return onMismatch
 	}
 	switch op {
@@ -690,32 +787,61 @@ func (reval bvcre) eval(bv *querypb.BindVariable, op Operator, onMismatch bool)
 	panic("unreachable")
 }
 
-// getuint64 returns QROutOfRange for negative values
+// getuint64 returns QROutOfRange for negative values.
+// Values that can't be converted to a whole number, like
+// VARCHAR "abc", are also treated as out of range, but are
+// counted as mismatches. VARCHAR "20000" is converted.
 func getuint64(val *querypb.BindVariable) (uv uint64, status int) {
 	bv, err := sqltypes.BindVariableToValue(val)
 	if err != nil {
+		bindVarMismatches.Add("Uint64", 1)
 		return 0, QROutOfRange
 	}
 	v, err := sqltypes.ToUint64(bv)
 	if err != nil {
+		if !bv.IsIntegral() {
+			bindVarMismatches.Add("Uint64", 1)
+		}
 		return 0, QROutOfRange
 	}
 	return v, QROK
 }
 
-// getint64 returns QROutOfRange if a uint64 is too large
+// getint64 returns QROutOfRange if a uint64 is too large.
+// Values that can't be converted to a whole number are also
+// treated as out of range, but are counted as mismatches.
 func getint64(val *querypb.BindVariable) (iv int64, status int) {
 	bv, err := sqltypes.BindVariableToValue(val)
 	if err != nil {
+		bindVarMismatches.Add("Int64", 1)
 		return 0, QROutOfRange
 	}
 	v, err := sqltypes.ToInt64(bv)
 	if err != nil {
+		if !bv.IsIntegral() {
+			bindVarMismatches.Add("Int64", 1)
+		}
 		return 0, QROutOfRange
 	}
 	return v, QROK
 }
 
+// getfloat64 returns QRMismatch if the value can't be converted
+// to a number, and counts it as a mismatch.
+func getfloat64(val *querypb.BindVariable) (fv float64, status int) {
+	bv, err := sqltypes.BindVariableToValue(val)
+	if err != nil {
+		bindVarMismatches.Add("Float64", 1)
+		return 0, QRMismatch
+	}
+	v, err := sqltypes.ToFloat64(bv)
+	if err != nil {
+		bindVarMismatches.Add("Float64", 1)
+		return 0, QRMismatch
+	}
+	return v, QROK
+}
+
 // TODO(sougou): this is inefficient. Optimize to use []byte.
 func getstring(val *querypb.BindVariable) (s string, status int) {
 	if sqltypes.IsIntegral(val.Type) || sqltypes.IsFloat(val.Type) || sqltypes.IsText(val.Type) || sqltypes.IsBinary(val.Type) {
@@ -873,7 +999,32 @@ func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch b
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Value missing in BindVarConds")
 		return
 	}
-	if op >= QREqual && op <= QRLessEqual {
+	length := false
+	if lv, ok := bvcinfo["Length"]; ok {
+		length, ok = lv.(bool)
+		if !ok {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want bool for Length")
+			return
+		}
+	}
+	if length {
+		if op < QREqual || op > QRLessEqual {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Operator %v for Length", strop)
+			return
+		}
+		num, ok := v.(json.Number)
+		if !ok {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for Length: %v", v)
+			return
+		}
+		var n int64
+		n, err = num.Int64()
+		if err != nil {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 for Length: %s", string(num))
+			return
+		}
+		value = ListLength(n)
+	} else if op >= QREqual && op <= QRLessEqual {
 		switch v := v.(type) {
 		case json.Number:
 			value, err = v.Int64()
@@ -881,8 +1032,12 @@ func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch b
 				// Maybe uint64
 				value, err = strconv.ParseUint(string(v), 10, 64)
 				if err != nil {
-					err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64/uint64: %s", string(v))
-					return
+					// Maybe float64
+					value, err = v.Float64()
+					if err != nil {
+						err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64/uint64/float64: %s", string(v))
+						return
+					}
 				}
 			}
 		case string:
@@ -323,6 +323,20 @@ var creationCases = []BVCreation{
 	{"a", true, true, QRGreaterThan, int64(1), false},
 	{"a", true, true, QRLessEqual, int64(1), false},
 
+	{"a", true, true, QREqual, float64(1.5), false},
+	{"a", true, true, QRNotEqual, float64(1.5), false},
+	{"a", true, true, QRLessThan, float64(1.5), false},
+	{"a", true, true, QRGreaterEqual, float64(1.5), false},
+	{"a", true, true, QRGreaterThan, float64(1.5), false},
+	{"a", true, true, QRLessEqual, float64(1.5), false},
+
+	{"a", true, true, QREqual, ListLength(1), false},
+	{"a", true, true, QRNotEqual, ListLength(1), false},
+	{"a", true, true, QRLessThan, ListLength(1), false},
+	{"a", true, true, QRGreaterEqual, ListLength(1), false},
+	{"a", true, true, QRGreaterThan, ListLength(1), false},
+	{"a", true, true, QRLessEqual, ListLength(1), false},
+
 	{"a", true, true, QREqual, "a", false},
 	{"a", true, true, QRNotEqual, "a", false},
 	{"a", true, true, QRLessThan, "a", false},
@@ -336,6 +350,8 @@ var creationCases = []BVCreation{
 	{"a", true, true, QRNoMatch, int64(1), true},
 	{"a", true, true, QRMatch, "[", true},
 	{"a", true, true, QRNoMatch, "[", true},
+	{"a", true, true, QRMatch, float64(1.5), true},
+	{"a", true, true, QRMatch, ListLength(1), true},
 }
 
 func TestBVCreation(t *testing.T) {
@@ -366,6 +382,7 @@ var bvtestcases = []BindVarTestCase{
 	{BindVarCond{"a", true, true, QREqual, bvcuint64(10)}, sqltypes.Uint64BindVariable(1), false},
 	{BindVarCond{"a", true, true, QREqual, bvcuint64(10)}, sqltypes.Uint64BindVariable(10), true},
 	{BindVarCond{"a", true, true, QREqual, bvcuint64(10)}, sqltypes.StringBindVariable("abc"), false},
+	{BindVarCond{"a", true, false, QRGreaterThan, bvcuint64(10000)}, sqltypes.StringBindVariable("20000"), true},
 
 	{BindVarCond{"a", true, true, QRNotEqual, bvcuint64(10)}, sqltypes.Int64BindVariable(1), true},
 	{BindVarCond{"a", true, true, QRNotEqual, bvcuint64(10)}, sqltypes.Int64BindVariable(10), false},
@@ -398,6 +415,7 @@ var bvtestcases = []BindVarTestCase{
 	{BindVarCond{"a", true, true, QREqual, bvcint64(10)}, sqltypes.Uint64BindVariable(0xFFFFFFFFFFFFFFFF), false},
 	{BindVarCond{"a", true, true, QREqual, bvcint64(10)}, sqltypes.Uint64BindVariable(10), true},
 	{BindVarCond{"a", true, true, QREqual, bvcint64(10)}, sqltypes.StringBindVariable("abc"), false},
+	{BindVarCond{"a", true, false, QRGreaterThan, bvcint64(10000)}, sqltypes.StringBindVariable("20000"), true},
 
 	{BindVarCond{"a", true, true, QRNotEqual, bvcint64(10)}, sqltypes.Int64BindVariable(1), true},
 	{BindVarCond{"a", true, true, QRNotEqual, bvcint64(10)}, sqltypes.Int64BindVariable(10), false},
@@ -424,6 +442,35 @@ var bvtestcases = []BindVarTestCase{
 	{BindVarCond{"a", true, true, QRLessEqual, bvcint64(10)}, sqltypes.Int64BindVariable(11), false},
 	{BindVarCond{"a", true, true, QRLessEqual, bvcint64(10)}, sqltypes.Uint64BindVariable(0xFFFFFFFFFFFFFFFF), false},
 
+	{BindVarCond{"a", true, true, QREqual, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(1.5), true},
+	{BindVarCond{"a", true, true, QREqual, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(2.5), false},
+	{BindVarCond{"a", true, true, QRNotEqual, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(2.5), true},
+	{BindVarCond{"a", true, true, QRLessThan, bvcfloat64(1.5)}, sqltypes.Int64BindVariable(1), true},
+	{BindVarCond{"a", true, true, QRLessThan, bvcfloat64(1.5)}, sqltypes.Uint64BindVariable(2), false},
+	{BindVarCond{"a", true, true, QRGreaterEqual, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(1.5), true},
+	{BindVarCond{"a", true, true, QRGreaterEqual, bvcfloat64(1.5)}, sqltypes.Int64BindVariable(-1), false},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvcfloat64(1.5)}, sqltypes.Uint64BindVariable(0xFFFFFFFFFFFFFFFF), true},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(1.5), false},
+	{BindVarCond{"a", true, true, QRLessEqual, bvcfloat64(1.5)}, sqltypes.Float64BindVariable(1.5), true},
+	{BindVarCond{"a", true, true, QRLessEqual, bvcfloat64(1.5)}, sqltypes.StringBindVariable("1.25"), true},
+	{BindVarCond{"a", true, true, QRLessEqual, bvcfloat64(1.5)}, sqltypes.StringBindVariable("abc"), true},
+	{BindVarCond{"a", true, false, QRLessEqual, bvcfloat64(1.5)}, sqltypes.StringBindVariable("abc"), false},
+	{BindVarCond{"a", true, false, QRGreaterThan, bvcfloat64(10000)}, sqltypes.StringBindVariable("20000"), true},
+
+	{BindVarCond{"a", true, true, QREqual, bvclength(2)}, makelist(2), true},
+	{BindVarCond{"a", true, true, QREqual, bvclength(2)}, makelist(3), false},
+	{BindVarCond{"a", true, true, QRNotEqual, bvclength(2)}, makelist(3), true},
+	{BindVarCond{"a", true, true, QRLessThan, bvclength(2)}, makelist(1), true},
+	{BindVarCond{"a", true, true, QRLessThan, bvclength(2)}, makelist(2), false},
+	{BindVarCond{"a", true, true, QRGreaterEqual, bvclength(2)}, makelist(2), true},
+	{BindVarCond{"a", true, true, QRGreaterEqual, bvclength(2)}, makelist(0), false},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclength(2)}, makelist(3), true},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclength(2)}, makelist(2), false},
+	{BindVarCond{"a", true, true, QRLessEqual, bvclength(2)}, makelist(2), true},
+	{BindVarCond{"a", true, true, QRLessEqual, bvclength(2)}, makelist(3), false},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclength(2)}, sqltypes.Int64BindVariable(10), true},
+	{BindVarCond{"a", true, false, QRGreaterThan, bvclength(2)}, sqltypes.Int64BindVariable(10), false},
+
 	{BindVarCond{"a", true, true, QREqual, bvcstring("b")}, sqltypes.StringBindVariable("a"), false},
 	{BindVarCond{"a", true, true, QREqual, bvcstring("b")}, sqltypes.StringBindVariable("b"), true},
 	{BindVarCond{"a", true, true, QREqual, bvcstring("b")}, sqltypes.StringBindVariable("c"), false},
@@ -461,6 +508,18 @@ var bvtestcases = []BindVarTestCase{
 	{BindVarCond{"a", true, true, QRNoMatch, makere("a.*")}, sqltypes.Int64BindVariable(1), true},
 }
 
+func makelist(n int) *querypb.BindVariable {
+	vals := make([]interface{}, n)
+	for i := range vals {
+		vals[i] = int64(i)
+	}
+	bv, err := sqltypes.BuildBindVariable(vals)
+	if err != nil {
+		panic(err)
+	}
+	return bv
+}
+
 func makere(s string) bvcre {
 	re, _ := regexp.Compile(s)
 	return bvcre{re}
@@ -476,6 +535,29 @@ func TestBVConditions(t *testing.T) {
 	}
 }
 
+func TestBVMismatchCount(t *testing.T) {
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.StringBindVariable("abc"),
+	}
+	for _, tcase := range []struct {
+		bvc BindVarCond
+		key string
+	}{
+		{BindVarCond{"a", true, false, QREqual, bvcint64(10)}, "Int64"},
+		{BindVarCond{"a", true, false, QREqual, bvcuint64(10)}, "Uint64"},
+		{BindVarCond{"a", true, false, QREqual, bvcfloat64(10)}, "Float64"},
+		{BindVarCond{"a", true, false, QREqual, bvclength(10)}, "Length"},
+	} {
+		before := bindVarMismatches.Counts()[tcase.key]
+		if bvMatch(tcase.bvc, bv) {
+			t.Errorf("bvmatch(%+v, %v): true, want false", tcase.bvc, bv["a"])
+		}
+		if got, want := bindVarMismatches.Counts()[tcase.key], before+1; got != want {
+			t.Errorf("%s mismatches: %d, want %d", tcase.key, got, want)
+		}
+	}
+}
+
 func TestAction(t *testing.T) {
 	qrs := New()
 
@@ -520,6 +602,16 @@ func TestAction(t *testing.T) {
 	if desc != "rule 3" {
 		t.Errorf("want rule 2, got %s", desc)
 	}
+
+	action, qr := qrs.GetActionRule("1234", "user", bv)
+	if action != QRFailRetry || qr == nil || qr.Name != "r2" {
+		t.Errorf("GetActionRule: %v, %v, want fail_retry, r2", action, qr)
+	}
+	bv["a"] = sqltypes.Uint64BindVariable(0)
+	action, qr = qrs.GetActionRule("1234", "user1", bv)
+	if action != QRContinue || qr != nil {
+		t.Errorf("GetActionRule: %v, %v, want continue, nil", action, qr)
+	}
 }
 
 func TestImport(t *testing.T) {
@@ -542,6 +634,19 @@ func TestImport(t *testing.T) {
 			"OnMismatch": true,
 			"Operator": "==",
 			"Value": 123
+		},{
+			"Name": "bvname3",
+			"OnAbsent": false,
+			"OnMismatch": false,
+			"Operator": "!=",
+			"Value": 2.0
+		},{
+			"Name": "bvname4",
+			"OnAbsent": false,
+			"OnMismatch": false,
+			"Length": true,
+			"Operator": "==",
+			"Value": 500
 		}],
 		"Action": "FAIL_RETRY"
 	},{
@@ -572,6 +677,8 @@ const (
 	INT
 	STR
 	REGEXP
+	FLOAT
+	LENGTH
 )
 
 var validjsons = []ValidJSONCase{
@@ -589,6 +696,14 @@ var validjsons = []ValidJSONCase{
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": ">", "Value": -123}]}]`, QRGreaterThan, INT},
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "<=", "Value": -123}]}]`, QRLessEqual, INT},
 
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "==", "Value": 1.5}]}]`, QREqual, FLOAT},
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "<", "Value": 1.5}]}]`, QRLessThan, FLOAT},
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": ">=", "Value": 1.5}]}]`, QRGreaterEqual, FLOAT},
+
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Length": true, "Operator": "==", "Value": 500}]}]`, QREqual, LENGTH},
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Length": true, "Operator": ">", "Value": 500}]}]`, QRGreaterThan, LENGTH},
+	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Length": true, "Operator": "<=", "Value": 500}]}]`, QRLessEqual, LENGTH},
+
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "==", "Value": "123"}]}]`, QREqual, STR},
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "!=", "Value": "123"}]}]`, QRNotEqual, STR},
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "<", "Value": "123"}]}]`, QRLessThan, STR},
@@ -628,6 +743,14 @@ func TestValidJSON(t *testing.T) {
 			if bvc.value.(bvcre).re == nil {
 				t.Errorf("want non-nil")
 			}
+		case FLOAT:
+			if bvc.value.(bvcfloat64) != 1.5 {
+				t.Errorf("want %v, got %v", 1.5, bvc.value.(bvcfloat64))
+			}
+		case LENGTH:
+			if bvc.value.(bvclength) != 500 {
+				t.Errorf("want %v, got %v", 500, bvc.value.(bvclength))
+			}
 		}
 	}
 }
@@ -659,7 +782,11 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true}]}]`, "Operator missing in BindVarConds"},
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "a"}]}]`, "invalid Operator a"},
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "=="}]}]`, "Value missing in BindVarConds"},
-	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "==", "Value": 1.2}]}]`, "want int64/uint64: 1.2"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "==", "Value": 1e400}]}]`, "want int64/uint64/float64: 1e400"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Length": 1, "Operator": "==", "Value": 1}]}]`, "want bool for Length"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Length": true, "Operator": "MATCH", "Value": 1}]}]`, "invalid Operator MATCH for Length"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Length": true, "Operator": "==", "Value": "1"}]}]`, "want number for Length: 1"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Length": true, "Operator": "==", "Value": 1.5}]}]`, "want int64 for Length: 1.5"},
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "==", "Value": {}}]}]`, "want string or number: map[]"},
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "MATCH", "Value": 1}]}]`, "want string: 1"},
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "Operator": "NOMATCH", "Value": 1}]}]`, "want string: 1"},
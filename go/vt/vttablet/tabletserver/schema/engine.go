@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/sync2"
 	"github.com/youtube/vitess/go/timer"
 	"github.com/youtube/vitess/go/vt/concurrency"
 	"github.com/youtube/vitess/go/vt/dbconfigs"
@@ -58,11 +60,22 @@ type Engine struct {
 	lastChange int64
 	reloadTime time.Duration
 	notifiers  map[string]notifier
+	// tableVersion is the last Version assigned to a loaded table.
+	tableVersion int64
 
 	// The following fields have their own synchronization
 	// and do not require locking mu.
 	conns *connpool.Pool
 	ticks *timer.Timer
+
+	// driftCount counts the tables which the periodic reload found
+	// created, dropped, or with a definition (columns, indexes, primary
+	// key, or table type) different from the cached one. These are
+	// changes that were made without going through this tablet's DDL
+	// path, like DDLs applied by replication or directly on MySQL.
+	// Tables which are reloaded only because of their create time
+	// are not counted if their definition didn't change.
+	driftCount sync2.AtomicInt64
 }
 
 var schemaOnce sync.Once
@@ -76,8 +89,10 @@ func NewEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *En
 		ticks:      timer.NewTimer(reloadTime),
 		reloadTime: reloadTime,
 	}
+	se.conns.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
 	schemaOnce.Do(func() {
 		stats.Publish("SchemaReloadTime", stats.DurationFunc(se.ticks.Interval))
+		stats.Publish("SchemaDriftDetections", stats.IntFunc(se.driftCount.Get))
 		_ = stats.NewMultiCountersFunc("TableRows", []string{"Table"}, se.getTableRows)
 		_ = stats.NewMultiCountersFunc("DataLength", []string{"Table"}, se.getDataLength)
 		_ = stats.NewMultiCountersFunc("IndexLength", []string{"Table"}, se.getIndexLength)
@@ -161,6 +176,8 @@ func (se *Engine) Open() error {
 			}
 			table.SetMysqlStats(row[4], row[5], row[6], row[7], row[8])
 			mu.Lock()
+			se.tableVersion++
+			table.Version = se.tableVersion
 			tables[tableName] = table
 			mu.Unlock()
 		}(row)
@@ -174,7 +191,7 @@ func (se *Engine) Open() error {
 	se.tables = tables
 	se.lastChange = curTime
 	se.ticks.Start(func() {
-		if err := se.Reload(ctx); err != nil {
+		if err := se.reload(ctx, true); err != nil {
 			log.Errorf("periodic schema reload failed: %v", err)
 		}
 	})
@@ -218,6 +235,12 @@ func (se *Engine) MakeNonMaster() {
 // Any tables that have changed since the last load are updated.
 // This is a no-op if the Engine is closed.
 func (se *Engine) Reload(ctx context.Context) error {
+	return se.reload(ctx, false)
+}
+
+// reload performs a Reload. If periodic is set, every table that is
+// created, dropped, or whose definition changed is counted as a drift.
+func (se *Engine) reload(ctx context.Context, periodic bool) error {
 	se.mu.Lock()
 	defer se.mu.Unlock()
 	if !se.isOpen {
@@ -255,7 +278,8 @@ func (se *Engine) Reload(ctx context.Context) error {
 		curTables[tableName] = true
 		createTime, _ := sqltypes.ToInt64(row[2])
 		// Check if we know about the table or it has been recreated.
-		if _, ok := se.tables[tableName]; !ok || createTime >= se.lastChange {
+		cached := se.tables[tableName]
+		if cached == nil || createTime >= se.lastChange {
 			func() {
 				// Unlock so TableWasCreatedOrAltered can lock.
 				se.mu.Unlock()
@@ -267,6 +291,9 @@ func (se *Engine) Reload(ctx context.Context) error {
 			if !se.isOpen {
 				return nil
 			}
+			if periodic && (cached == nil || !sameDefinition(cached, se.tables[tableName])) {
+				se.driftCount.Add(1)
+			}
 			continue
 		}
 		// Only update table_rows, data_length, index_length, max_data_length
@@ -283,6 +310,9 @@ func (se *Engine) Reload(ctx context.Context) error {
 		delete(se.tables, tableName)
 		dropped = append(dropped, tableName)
 	}
+	if periodic {
+		se.driftCount.Add(int64(len(dropped)))
+	}
 	// We only need to broadcast dropped tables because
 	// TableWasCreatedOrAltered will broadcast the other changes.
 	if len(dropped) > 0 {
@@ -291,6 +321,26 @@ func (se *Engine) Reload(ctx context.Context) error {
 	return rec.Error()
 }
 
+// sameDefinition returns true if the two tables have the same
+// columns, indexes, primary key, type and message options. Index
+// cardinalities and MySQL stats are not compared.
+func sameDefinition(a, b *Table) bool {
+	if b == nil || a.Type != b.Type || len(a.Indexes) != len(b.Indexes) {
+		return false
+	}
+	if !reflect.DeepEqual(a.Columns, b.Columns) || !reflect.DeepEqual(a.PKColumns, b.PKColumns) {
+		return false
+	}
+	for i, index := range a.Indexes {
+		other := b.Indexes[i]
+		if !index.Name.Equal(other.Name) || index.Unique != other.Unique || !reflect.DeepEqual(index.Columns, other.Columns) {
+			return false
+		}
+	}
+	// The type tells sequences apart. SequenceInfo holds no definition.
+	return reflect.DeepEqual(a.MessageInfo, b.MessageInfo)
+}
+
 func (se *Engine) mysqlTime(ctx context.Context, conn *connpool.DBConn) (int64, error) {
 	tm, err := conn.Exec(ctx, "select unix_timestamp()", 1, false)
 	if err != nil {
@@ -313,7 +363,27 @@ func (se *Engine) TableWasCreatedOrAltered(ctx context.Context, tableName string
 	if !se.isOpen {
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "DDL called on closed schema")
 	}
+	return se.reloadTable(ctx, "TableWasCreatedOrAltered", tableName, false)
+}
+
+// ReloadTable reloads the schema of a single table, which is much
+// cheaper than a full Reload if there are many tables. Unlike
+// TableWasCreatedOrAltered, it fails if the table does not exist.
+// It returns the Version of the reloaded table.
+func (se *Engine) ReloadTable(ctx context.Context, tableName string) (int64, error) {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	if !se.isOpen {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "ReloadTable called on closed schema")
+	}
+	if err := se.reloadTable(ctx, "ReloadTable", tableName, true); err != nil {
+		return 0, err
+	}
+	return se.tables[tableName].Version, nil
+}
 
+// reloadTable must be called while holding a lock on se.mu.
+func (se *Engine) reloadTable(ctx context.Context, caller, tableName string, mustExist bool) error {
 	conn, err := se.conns.Get(ctx)
 	if err != nil {
 		return err
@@ -322,9 +392,12 @@ func (se *Engine) TableWasCreatedOrAltered(ctx context.Context, tableName string
 	tableData, err := conn.Exec(ctx, mysql.BaseShowTablesForTable(tableName), 1, false)
 	if err != nil {
 		tabletenv.InternalErrors.Add("Schema", 1)
-		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "TableWasCreatedOrAltered: information_schema query failed for table %s: %v", tableName, err)
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "%s: information_schema query failed for table %s: %v", caller, tableName, err)
 	}
 	if len(tableData.Rows) != 1 {
+		if mustExist {
+			return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "%s: table %s not found in MySQL", caller, tableName)
+		}
 		// This can happen if DDLs race with each other.
 		return nil
 	}
@@ -337,7 +410,7 @@ func (se *Engine) TableWasCreatedOrAltered(ctx context.Context, tableName string
 	)
 	if err != nil {
 		tabletenv.InternalErrors.Add("Schema", 1)
-		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "TableWasCreatedOrAltered: failed to load table %s: %v", tableName, err)
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "%s: failed to load table %s: %v", caller, tableName, err)
 	}
 	// table_rows, data_length, index_length, max_data_length
 	table.SetMysqlStats(row[4], row[5], row[6], row[7], row[8])
@@ -352,8 +425,10 @@ func (se *Engine) TableWasCreatedOrAltered(ctx context.Context, tableName string
 	} else {
 		created = append(created, tableName)
 	}
+	se.tableVersion++
+	table.Version = se.tableVersion
 	se.tables[tableName] = table
-	log.Infof("Initialized table: %s, type: %s", tableName, TypeNames[table.Type])
+	log.Infof("Initialized table: %s, type: %s, version: %d", tableName, TypeNames[table.Type], table.Version)
 	se.broadcast(created, altered, nil)
 	return nil
 }
@@ -33,10 +33,12 @@ import (
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/dbconfigs"
 	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/schema/schematest"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
 func TestOpenFailedDueToMissMySQLTime(t *testing.T) {
@@ -229,6 +231,96 @@ func TestReload(t *testing.T) {
 	}
 }
 
+func TestReloadDrift(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ctx := context.Background()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	se.Open()
+	defer se.Close()
+
+	for _, row := range schematest.Queries()[mysql.BaseShowTables].Rows {
+		db.AddQuery(mysql.BaseShowTablesForTable(row[0].ToString()), &sqltypes.Result{
+			Fields:       mysql.BaseShowTablesFields,
+			RowsAffected: 1,
+			Rows:         [][]sqltypes.Value{row},
+		})
+	}
+	// The tables were created in the same second as the last reload,
+	// so they're reloaded again, but they didn't change.
+	if err := se.reload(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := se.driftCount.Get(); got != 0 {
+		t.Errorf("driftCount after unchanged periodic reload: %d, want 0", got)
+	}
+
+	// Add a column behind the engine's back.
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "name",
+			Type: sqltypes.VarChar,
+		}},
+	})
+	db.AddQuery("describe test_table_01", &sqltypes.Result{
+		Fields:       mysql.DescribeTableFields,
+		RowsAffected: 2,
+		Rows: [][]sqltypes.Value{
+			mysql.DescribeTableRow("pk", "int(11)", false, "PRI", "0"),
+			mysql.DescribeTableRow("name", "varchar(10)", false, "", ""),
+		},
+	})
+	if err := se.reload(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := se.driftCount.Get(); got != 1 {
+		t.Errorf("driftCount after altered periodic reload: %d, want 1", got)
+	}
+
+	// Drop every table behind the engine's back.
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+	})
+	want := int64(len(se.GetSchema())) // all but dual, plus the column
+	if err := se.reload(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := se.driftCount.Get(); got != want {
+		t.Errorf("driftCount after periodic reload: %d, want %d", got, want)
+	}
+
+	// Changes found by an explicit reload are not drifts.
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+		},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_01"), &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+		},
+	})
+	if err := se.Reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if se.GetTable(sqlparser.NewTableIdent("test_table_01")) == nil {
+		t.Errorf("test_table_01 was not reloaded")
+	}
+	if got := se.driftCount.Get(); got != want {
+		t.Errorf("driftCount after Reload: %d, want %d", got, want)
+	}
+}
+
 func TestCreateOrUpdateTableFailedDuetoExecErr(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -293,6 +385,57 @@ func TestCreateOrUpdateTable(t *testing.T) {
 	}
 }
 
+func TestReloadTable(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 1*time.Second, 1*time.Second, false, db)
+	se.Open()
+	defer se.Close()
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_01"), &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+		},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_missing"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+	})
+
+	var altered []string
+	se.RegisterNotifier("test", func(schema map[string]*Table, c, a, d []string) {
+		altered = append(altered, a...)
+	})
+	defer se.UnregisterNotifier("test")
+	before := se.GetTable(sqlparser.NewTableIdent("test_table_01")).Version
+	version, err := se.ReloadTable(context.Background(), "test_table_01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version <= before {
+		t.Errorf("ReloadTable(test_table_01) version: %d, want > %d", version, before)
+	}
+	if got := se.GetTable(sqlparser.NewTableIdent("test_table_01")).Version; got != version {
+		t.Errorf("test_table_01 version: %d, want %d", got, version)
+	}
+	want := []string{"test_table_01"}
+	if !reflect.DeepEqual(altered, want) {
+		t.Errorf("altered: %v, want %v", altered, want)
+	}
+
+	_, err = se.ReloadTable(context.Background(), "test_table_missing")
+	wantErr := "ReloadTable: table test_table_missing not found in MySQL"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("ReloadTable(test_table_missing): %v, want %s", err, wantErr)
+	}
+	if got := vterrors.Code(err); got != vtrpcpb.Code_NOT_FOUND {
+		t.Errorf("ReloadTable(test_table_missing) code: %v, want %v", got, vtrpcpb.Code_NOT_FOUND)
+	}
+}
+
 func TestExportVars(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -423,6 +566,15 @@ func (dummyChecker) CheckMySQL() {}
 
 var DummyChecker = dummyChecker{}
 
+func TestEngineConnMaxLifetime(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.ConnMaxLifetime = 60
+	se := NewEngine(DummyChecker, config)
+	if got, want := se.conns.MaxLifetime(), time.Minute; got != want {
+		t.Errorf("MaxLifetime: %v, want %v", got, want)
+	}
+}
+
 func newEngine(queryPlanCacheSize int, reloadTime time.Duration, idleTimeout time.Duration, strict bool, db *fakesqldb.DB) *Engine {
 	config := tabletenv.DefaultQsConfig
 	config.QueryPlanCacheSize = queryPlanCacheSize
@@ -62,6 +62,10 @@ type Table struct {
 	PKColumns []int
 	Type      int
 
+	// Version is assigned by the Engine every time the table
+	// is loaded. It increases with every reload.
+	Version int64
+
 	// SequenceInfo contains info for sequence tables.
 	SequenceInfo *SequenceInfo
 
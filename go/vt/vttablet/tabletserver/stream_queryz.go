@@ -104,3 +104,23 @@ func streamQueryzTerminateHandler(queryList *QueryList, w http.ResponseWriter, r
 	}
 	streamQueryzHandler(queryList, w, r)
 }
+
+// queriesKillHandler kills all the running queries of a caller.
+// Endpoint: /debug/queries/kill?caller=<caller id>
+func queriesKillHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	caller := r.FormValue("caller")
+	if caller == "" {
+		http.Error(w, "missing caller", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintf(w, "killed %d queries of %s\n", tsv.TerminateCallerQueries(caller), caller)
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strconv"
 	"time"
 
 	log "github.com/golang/glog"
@@ -57,13 +58,20 @@ func init() {
 	flag.IntVar(&Config.WarnResultSize, "queryserver-config-warn-result-size", DefaultQsConfig.WarnResultSize, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
 	flag.IntVar(&Config.MaxDMLRows, "queryserver-config-max-dml-rows", DefaultQsConfig.MaxDMLRows, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&Config.PassthroughDMLs, "queryserver-config-passthrough-dmls", DefaultQsConfig.PassthroughDMLs, "query server pass through all dml statements without rewriting")
+	flag.BoolVar(&Config.EnableConsolidator, "enable-consolidator", DefaultQsConfig.EnableConsolidator, "This option enables the query consolidator. Identical SELECTs executing concurrently outside of a transaction share a single MySQL query and its result.")
+	flag.IntVar(&Config.ConsolidatorMaxResultSize, "queryserver-config-consolidator-max-result-size", DefaultQsConfig.ConsolidatorMaxResultSize, "query server consolidator max result size (in bytes). Results larger than this are not shared between consolidated queries, which then execute on their own. 0 means no limit.")
 
 	flag.IntVar(&Config.StreamBufferSize, "queryserver-config-stream-buffer-size", DefaultQsConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
+	flag.IntVar(&Config.StreamBufferRows, "queryserver-config-stream-buffer-rows", DefaultQsConfig.StreamBufferRows, "query server stream buffer rows, the maximum number of rows sent from vttablet for each stream call. 0 means only queryserver-config-stream-buffer-size applies.")
 	flag.IntVar(&Config.QueryPlanCacheSize, "queryserver-config-query-cache-size", DefaultQsConfig.QueryPlanCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	flag.BoolVar(&Config.NormalizeINLists, "queryserver-config-normalize-in-lists", DefaultQsConfig.NormalizeINLists, "query server IN list normalization. If true, IN clauses with a list of values are rewritten to use a list bind variable before the plan lookup. Queries which differ only in the number of IN values then share a single query plan. This costs a parse of every query that has an IN clause, even if its plan is cached.")
+	flag.IntVar(&Config.QueryPlanCacheMemory, "queryserver-config-query-cache-memory", DefaultQsConfig.QueryPlanCacheMemory, "query server query cache size in bytes, maximum amount of memory to be used for caching query plans. If set, this overrides queryserver-config-query-cache-size, and the lru cache evicts plans based on their estimated size instead of their number.")
 	flag.Float64Var(&Config.SchemaReloadTime, "queryserver-config-schema-reload-time", DefaultQsConfig.SchemaReloadTime, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	flag.Float64Var(&Config.QueryTimeout, "queryserver-config-query-timeout", DefaultQsConfig.QueryTimeout, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
+	flag.Var(&Config.CallerQueryTimeouts, "queryserver-config-caller-query-timeouts", "Comma-separated list of username:seconds pairs which restrict the query timeout of specific callers below -queryserver-config-query-timeout. The username is the VTGate caller id. Values higher than -queryserver-config-query-timeout have no effect.")
 	flag.Float64Var(&Config.TxPoolTimeout, "queryserver-config-txpool-timeout", DefaultQsConfig.TxPoolTimeout, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	flag.Float64Var(&Config.IdleTimeout, "queryserver-config-idle-timeout", DefaultQsConfig.IdleTimeout, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
+	flag.Float64Var(&Config.ConnMaxLifetime, "queryserver-config-conn-max-lifetime", DefaultQsConfig.ConnMaxLifetime, "query server connection max lifetime (in seconds). Connections older than this are closed when they are returned to their pool, and replaced on demand. 0 means connections are never recycled.")
 	// tableacl related configurations.
 	flag.BoolVar(&Config.StrictTableACL, "queryserver-config-strict-table-acl", DefaultQsConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&Config.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", DefaultQsConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
@@ -71,6 +79,7 @@ func init() {
 	flag.BoolVar(&Config.TerseErrors, "queryserver-config-terse-errors", DefaultQsConfig.TerseErrors, "prevent bind vars from escaping in returned errors")
 	flag.StringVar(&Config.PoolNamePrefix, "pool-name-prefix", DefaultQsConfig.PoolNamePrefix, "pool name prefix, vttablet has several pools and each of them has a name. This config specifies the prefix of these pool names")
 	flag.BoolVar(&Config.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to support the include_event_token ExecuteOptions.")
+	flag.Float64Var(&Config.ReplicationStallTimeout, "watch_replication_stall_timeout", DefaultQsConfig.ReplicationStallTimeout, "time in seconds. If -watch_replication_stream is enabled and no transaction is received for longer than this, the ReplicationWatcherStalled variable is set to 1. Only meaningful if the master writes regularly, e.g. with -heartbeat_enable. 0 disables the check.")
 	flag.BoolVar(&Config.EnableAutoCommit, "enable-autocommit", DefaultQsConfig.EnableAutoCommit, "if the flag is on, a DML outsides a transaction will be auto committed. This flag is deprecated and is unsafe. Instead, use the VTGate provided autocommit feature.")
 	flag.BoolVar(&Config.TwoPCEnable, "twopc_enable", DefaultQsConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&Config.TwoPCCoordinatorAddress, "twopc_coordinator_address", DefaultQsConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -84,6 +93,7 @@ func init() {
 	flag.IntVar(&Config.HotRowProtectionMaxQueueSize, "hot_row_protection_max_queue_size", DefaultQsConfig.HotRowProtectionMaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&Config.HotRowProtectionMaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", DefaultQsConfig.HotRowProtectionMaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&Config.HotRowProtectionConcurrentTransactions, "hot_row_protection_concurrent_transactions", DefaultQsConfig.HotRowProtectionConcurrentTransactions, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.Float64Var(&Config.HotRowProtectionQueueTimeout, "hot_row_protection_queue_timeout", DefaultQsConfig.HotRowProtectionQueueTimeout, "Maximum time (in seconds) a BeginExecute RPC waits in the queue of a hot row before it gets rejected. 0 means the wait is only limited by -queryserver-config-query-timeout.")
 
 	flag.BoolVar(&Config.EnableTransactionLimit, "enable_transaction_limit", DefaultQsConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&Config.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", DefaultQsConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -92,6 +102,7 @@ func init() {
 	flag.BoolVar(&Config.TransactionLimitByPrincipal, "transaction_limit_by_principal", DefaultQsConfig.TransactionLimitByPrincipal, "Include CallerID.principal when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&Config.TransactionLimitByComponent, "transaction_limit_by_component", DefaultQsConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&Config.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", DefaultQsConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
+	flag.Var(&Config.TransactionLimitPerUserOverrides, "transaction_limit_per_user_overrides", "Comma-separated list of user:fraction pairs that override -transaction_limit_per_user for specific users. The user is the key built from the -transaction_limit_by flags, for example username/principal.")
 
 	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&Config.HeartbeatInterval, "heartbeat_interval", DefaultQsConfig.HeartbeatInterval, "How frequently to read and write replication heartbeat.")
@@ -132,11 +143,15 @@ type TabletConfig struct {
 	MaxDMLRows              int
 	PassthroughDMLs         bool
 	StreamBufferSize        int
+	StreamBufferRows        int
 	QueryPlanCacheSize      int
+	QueryPlanCacheMemory    int
 	SchemaReloadTime        float64
 	QueryTimeout            float64
+	CallerQueryTimeouts     flagutil.StringMapValue
 	TxPoolTimeout           float64
 	IdleTimeout             float64
+	ConnMaxLifetime         float64
 	StrictTableACL          bool
 	TerseErrors             bool
 	EnableAutoCommit        bool
@@ -144,6 +159,7 @@ type TabletConfig struct {
 	PoolNamePrefix          string
 	TableACLExemptACL       string
 	WatchReplication        bool
+	ReplicationStallTimeout float64
 	TwoPCEnable             bool
 	TwoPCCoordinatorAddress string
 	TwoPCAbandonAge         float64
@@ -152,11 +168,17 @@ type TabletConfig struct {
 	TxThrottlerConfig           string
 	TxThrottlerHealthCheckCells []string
 
+	NormalizeINLists bool
+
+	EnableConsolidator        bool
+	ConsolidatorMaxResultSize int
+
 	EnableHotRowProtection                 bool
 	EnableHotRowProtectionDryRun           bool
 	HotRowProtectionMaxQueueSize           int
 	HotRowProtectionMaxGlobalQueueSize     int
 	HotRowProtectionConcurrentTransactions int
+	HotRowProtectionQueueTimeout           float64
 
 	TransactionLimitConfig
 
@@ -176,6 +198,38 @@ type TransactionLimitConfig struct {
 	TransactionLimitByPrincipal    bool
 	TransactionLimitByComponent    bool
 	TransactionLimitBySubcomponent bool
+	// TransactionLimitPerUserOverrides maps users to the fraction
+	// of the pool they can use, if different from TransactionLimitPerUser.
+	TransactionLimitPerUserOverrides flagutil.StringMapValue
+}
+
+// TransactionLimitOverrides returns the parsed per-user overrides.
+func (c *TransactionLimitConfig) TransactionLimitOverrides() (map[string]float64, error) {
+	overrides := make(map[string]float64, len(c.TransactionLimitPerUserOverrides))
+	for user, value := range c.TransactionLimitPerUserOverrides {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -transaction_limit_per_user_overrides value for %s: %v", user, err)
+		}
+		overrides[user] = v
+	}
+	return overrides, nil
+}
+
+// CallerQueryTimeoutMap returns the parsed per-caller query timeouts.
+func (c *TabletConfig) CallerQueryTimeoutMap() (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration, len(c.CallerQueryTimeouts))
+	for user, value := range c.CallerQueryTimeouts {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -queryserver-config-caller-query-timeouts value for %s: %v", user, err)
+		}
+		if v <= 0 {
+			return nil, fmt.Errorf("-queryserver-config-caller-query-timeouts value for %s must be > 0 (specified value: %v)", user, v)
+		}
+		timeouts[user] = time.Duration(v * 1e9)
+	}
+	return timeouts, nil
 }
 
 // DefaultQsConfig is the default value for the query service config.
@@ -199,11 +253,14 @@ var DefaultQsConfig = TabletConfig{
 	MaxDMLRows:              500,
 	PassthroughDMLs:         false,
 	QueryPlanCacheSize:      5000,
+	QueryPlanCacheMemory:    0,
 	SchemaReloadTime:        30 * 60,
 	QueryTimeout:            30,
 	TxPoolTimeout:           1,
 	IdleTimeout:             30 * 60,
+	ConnMaxLifetime:         0,
 	StreamBufferSize:        32 * 1024,
+	StreamBufferRows:        0,
 	StrictTableACL:          false,
 	TerseErrors:             false,
 	EnableAutoCommit:        false,
@@ -211,6 +268,7 @@ var DefaultQsConfig = TabletConfig{
 	PoolNamePrefix:          "",
 	TableACLExemptACL:       "",
 	WatchReplication:        false,
+	ReplicationStallTimeout: 0,
 	TwoPCEnable:             false,
 	TwoPCCoordinatorAddress: "",
 	TwoPCAbandonAge:         0,
@@ -219,6 +277,11 @@ var DefaultQsConfig = TabletConfig{
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
 	TxThrottlerHealthCheckCells: []string{},
 
+	NormalizeINLists: false,
+
+	EnableConsolidator:        true,
+	ConsolidatorMaxResultSize: 0,
+
 	EnableHotRowProtection:       false,
 	EnableHotRowProtectionDryRun: false,
 	// Default value is the same as TransactionCap.
@@ -227,6 +290,8 @@ var DefaultQsConfig = TabletConfig{
 	// Allow more than 1 transaction for the same hot row through to have enough
 	// of them ready in MySQL and profit from a pipelining effect.
 	HotRowProtectionConcurrentTransactions: 5,
+	// By default, the wait is only limited by the query timeout.
+	HotRowProtectionQueueTimeout: 0,
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
@@ -292,6 +357,18 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	if limit := int(c.TransactionLimitPerUser * float64(c.TransactionCap)); limit == 0 {
 		return fmt.Errorf("effective transaction limit per user is 0 due to rounding, increase -transaction_limit_per_user")
 	}
+	overrides, err := c.TransactionLimitOverrides()
+	if err != nil {
+		return err
+	}
+	for user, v := range overrides {
+		if v <= 0 || v > 1 {
+			return fmt.Errorf("-transaction_limit_per_user_overrides for %s should be a fraction within range (0, 1] (specified value: %v)", user, v)
+		}
+		if limit := int(v * float64(c.TransactionCap)); limit == 0 {
+			return fmt.Errorf("effective transaction limit for %s is 0 due to rounding, increase its -transaction_limit_per_user_overrides value", user)
+		}
+	}
 	return nil
 }
 
@@ -304,6 +381,9 @@ func VerifyConfig() error {
 	if err := Config.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if _, err := Config.CallerQueryTimeoutMap(); err != nil {
+		return err
+	}
 	if actual, dryRun := Config.EnableHotRowProtection, Config.EnableHotRowProtectionDryRun; actual && dryRun {
 		return errors.New("only one of two flags allowed: -enable_hot_row_protection or -enable_hot_row_protection_dry_run")
 	}
@@ -319,5 +399,8 @@ func VerifyConfig() error {
 	if v := Config.HotRowProtectionConcurrentTransactions; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if v := Config.HotRowProtectionQueueTimeout; v < 0 {
+		return fmt.Errorf("-hot_row_protection_queue_timeout must be >= 0 (specified value: %v)", v)
+	}
 	return nil
 }
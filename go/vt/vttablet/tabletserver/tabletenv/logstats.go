@@ -30,6 +30,7 @@ import (
 	"github.com/youtube/vitess/go/streamlog"
 	"github.com/youtube/vitess/go/vt/callerid"
 	"github.com/youtube/vitess/go/vt/callinfo"
+	"github.com/youtube/vitess/go/vt/sqlparser"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
@@ -133,6 +134,20 @@ func (stats *LogStats) SizeOfResponse() int {
 	return size
 }
 
+// RedactSQL returns sql with its literal values replaced by bind
+// variables if RedactDebugUIQueries is set. Queries that can't be
+// parsed are replaced entirely.
+func RedactSQL(sql string) string {
+	if !*streamlog.RedactDebugUIQueries {
+		return sql
+	}
+	redacted, err := sqlparser.RedactSQLQuery(sql)
+	if err != nil {
+		return "[REDACTED]"
+	}
+	return redacted
+}
+
 // FmtBindVariables returns the map of bind variables as a string or a json
 // string depending on the streamlog.QueryLogFormat value. If RedactDebugUIQueries
 // is true then this returns the string "[REDACTED]"
@@ -260,7 +275,7 @@ func (stats *LogStats) Format(params url.Values) string {
 		stats.EndTime.Format(time.StampMicro),
 		stats.TotalTime().Seconds(),
 		stats.PlanType,
-		stats.OriginalSQL,
+		RedactSQL(stats.OriginalSQL),
 		formattedBindVars,
 		stats.NumberOfQueries,
 		rewrittenSQL,
@@ -19,6 +19,8 @@ limitations under the License.
 package tabletenv
 
 import (
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/golang/glog"
@@ -37,12 +39,20 @@ var (
 	MySQLStats = stats.NewTimings("Mysql")
 	// QueryStats shows the time histogram for each type of queries.
 	QueryStats = stats.NewTimings("Queries")
+	// QueryStatsBySource shows the time histogram for each type of queries,
+	// broken down by where the results came from (see LogStats.FmtQuerySources).
+	QueryStatsBySource = stats.NewMultiTimings("QueriesBySource", []string{"Plan", "Source"})
 	// QPSRates shows the qps of QueryStats. Sample every 5 seconds and keep samples for up to 15 mins.
 	QPSRates = stats.NewRates("QPS", QueryStats, 15*60/5, 5*time.Second)
 	// WaitStats shows the time histogram for wait operations
 	WaitStats = stats.NewTimings("Waits")
+	// ConsolidatorStats shows how often queries joined an identical query in
+	// flight ("Hits"), how many of them reused its result ("Saved") and how
+	// many had to execute on their own because the result exceeded
+	// -queryserver-config-consolidator-max-result-size ("Skipped").
+	ConsolidatorStats = stats.NewCounters("Consolidator", "Hits", "Saved", "Skipped")
 	// KillStats shows number of connections being killed.
-	KillStats = stats.NewCounters("Kills", "Transactions", "Queries")
+	KillStats = stats.NewCounters("Kills", "Transactions", "Queries", "ManualTransactions")
 	// ErrorStats shows number of critial erros happened.
 	ErrorStats = stats.NewCounters(
 		"Errors",
@@ -65,21 +75,27 @@ var (
 		vtrpcpb.Code_DATA_LOSS.String(),
 	)
 	// InternalErrors shows number of errors from internal components.
-	InternalErrors = stats.NewCounters("InternalErrors", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages")
+	InternalErrors = stats.NewCounters("InternalErrors", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages", "ReplicationWatcher")
 	// Warnings shows number of warnings
 	Warnings = stats.NewCounters("Warnings", "ResultsExceeded")
+	// QueryRuleHits counts the queries rejected by each query rule, by rule name.
+	QueryRuleHits = stats.NewCounters("QueryRuleHits")
 	// Unresolved tracks unresolved items. For now it's just Prepares.
 	Unresolved = stats.NewCounters("Unresolved", "Prepares")
 	// UserTableQueryCount shows number of queries received for each CallerID/table combination.
 	UserTableQueryCount = stats.NewMultiCounters("UserTableQueryCount", []string{"TableName", "CallerID", "Type"})
 	// UserTableQueryTimesNs shows total latency for each CallerID/table combination.
 	UserTableQueryTimesNs = stats.NewMultiCounters("UserTableQueryTimesNs", []string{"TableName", "CallerID", "Type"})
+	// UserQueryKills shows number of queries killed for each CallerID, by reason.
+	UserQueryKills = stats.NewMultiCounters("UserQueryKills", []string{"CallerID", "Reason"})
 	// UserTransactionCount shows number of transactions received for each CallerID.
 	UserTransactionCount = stats.NewMultiCounters("UserTransactionCount", []string{"CallerID", "Conclusion"})
 	// UserTransactionTimesNs shows total transaction latency for each CallerID.
 	UserTransactionTimesNs = stats.NewMultiCounters("UserTransactionTimesNs", []string{"CallerID", "Conclusion"})
 	// ResultStats shows the histogram of number of rows returned.
-	ResultStats = stats.NewHistogram("Results", []int64{0, 1, 5, 10, 50, 100, 500, 1000, 5000, 10000})
+	ResultStats = stats.NewHistogram("Results", resultBuckets)
+	// ResultStatsByPlan shows the histogram of number of rows returned for each type of queries.
+	ResultStatsByPlan = &ResultHistograms{prefix: "Results", histograms: make(map[string]*stats.Histogram)}
 	// TableaclAllowed tracks the number allows.
 	TableaclAllowed = stats.NewMultiCounters("TableACLAllowed", []string{"TableName", "TableGroup", "PlanID", "Username"})
 	// TableaclDenied tracks the number of denials.
@@ -94,16 +110,96 @@ var (
 	Errorf = log.Errorf
 )
 
-// RecordUserQuery records the query data against the user.
-func RecordUserQuery(ctx context.Context, tableName sqlparser.TableIdent, queryType string, duration int64) {
+var resultBuckets = []int64{0, 1, 5, 10, 50, 100, 500, 1000, 5000, 10000}
+
+// ResultHistograms publishes one Histogram of number of rows returned
+// per category. A category like "PASS_SELECT" is published under the
+// prefix followed by the camel-cased category, like ResultsPassSelect.
+type ResultHistograms struct {
+	prefix     string
+	mu         sync.Mutex
+	histograms map[string]*stats.Histogram
+}
+
+// Get returns the histogram for the category, publishing it on first use.
+func (rh *ResultHistograms) Get(category string) *stats.Histogram {
+	rh.mu.Lock()
+	defer rh.mu.Unlock()
+	h, ok := rh.histograms[category]
+	if !ok {
+		h = stats.NewHistogram(rh.prefix+camelCase(category), resultBuckets)
+		rh.histograms[category] = h
+	}
+	return h
+}
+
+// camelCase converts a name like "PASS_SELECT" into "PassSelect".
+func camelCase(name string) string {
+	words := strings.Split(strings.ToLower(name), "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// CallerName returns the name the query stats of ctx are recorded against:
+// the effective caller's principal, or else the immediate caller's username.
+func CallerName(ctx context.Context) string {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx))
 	if username == "" {
 		username = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
 	}
+	return username
+}
+
+// RecordUserQuery records the query data against the user.
+func RecordUserQuery(ctx context.Context, tableName sqlparser.TableIdent, queryType string, duration int64) {
+	username := CallerName(ctx)
 	UserTableQueryCount.Add([]string{tableName.String(), username, queryType}, 1)
 	UserTableQueryTimesNs.Add([]string{tableName.String(), username, queryType}, int64(duration))
 }
 
+// RecordUserQueryKill records a killed query against the user.
+func RecordUserQueryKill(ctx context.Context, reason string) {
+	UserQueryKills.Add([]string{CallerName(ctx), reason}, 1)
+}
+
+var (
+	tableStatsMu       sync.Mutex
+	tableStatsDeleters []func(tableName string)
+)
+
+// RegisterTableStats registers a function which removes the per-table
+// stats of another package. DeleteTableStats calls it for every
+// dropped table.
+func RegisterTableStats(deleter func(tableName string)) {
+	tableStatsMu.Lock()
+	defer tableStatsMu.Unlock()
+	tableStatsDeleters = append(tableStatsDeleters, deleter)
+}
+
+// DeleteTableStats removes the per-table stats of a dropped table,
+// so that they don't grow without bound on schemas where tables
+// come and go.
+func DeleteTableStats(tableName string) {
+	for _, mc := range []*stats.MultiCounters{
+		UserTableQueryCount,
+		UserTableQueryTimesNs,
+		TableaclAllowed,
+		TableaclDenied,
+		TableaclPseudoDenied,
+	} {
+		mc.DeleteLabel("TableName", tableName)
+	}
+	tableStatsMu.Lock()
+	defer tableStatsMu.Unlock()
+	for _, deleter := range tableStatsDeleters {
+		deleter(tableName)
+	}
+}
+
 // LogError logs panics and increments InternalErrors.
 func LogError() {
 	if x := recover(); x != nil {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/streamlog"
 	"github.com/youtube/vitess/go/sync2"
 	"github.com/youtube/vitess/go/tb"
 	"github.com/youtube/vitess/go/vt/binlog"
@@ -115,6 +117,14 @@ type TabletServer struct {
 	BeginTimeout           sync2.AtomicDuration
 	TerseErrors            bool
 	enableHotRowProtection bool
+	// hotRowQueueTimeout limits how long a transaction waits in a hot row
+	// queue. If 0, only QueryTimeout applies.
+	hotRowQueueTimeout time.Duration
+
+	// callerTimeouts holds the per-query timeouts of callers that
+	// are restricted below QueryTimeout. It's protected by callerMu.
+	callerMu       sync.Mutex
+	callerTimeouts map[string]time.Duration
 
 	// mu is used to access state. The lock should only be held
 	// for short periods. For longer periods, you have to transition
@@ -147,10 +157,18 @@ type TabletServer struct {
 	messager         *messager.Engine
 	watcher          *ReplicationWatcher
 	updateStreamList *binlog.StreamList
+	updateStreamAcks *updateStreamAcks
 
 	// checkMySQLThrottler is used to throttle the number of
 	// requests sent to CheckMySQL.
 	checkMySQLThrottler *sync2.Semaphore
+	// mysqlUnreachable is the result of the last CheckMySQL,
+	// and checkMySQLFailures counts the failed ones.
+	// lastMySQLSuccess is the time, in nanoseconds since the
+	// epoch, at which MySQL was last known to be reachable.
+	mysqlUnreachable   sync2.AtomicBool
+	checkMySQLFailures sync2.AtomicInt64
+	lastMySQLSuccess   sync2.AtomicInt64
 
 	// txThrottler is used to throttle transactions based on the observed replication lag.
 	txThrottler *txthrottler.TxThrottler
@@ -200,6 +218,8 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 		BeginTimeout:           sync2.NewAtomicDuration(time.Duration(config.TxPoolTimeout * 1e9)),
 		TerseErrors:            config.TerseErrors,
 		enableHotRowProtection: config.EnableHotRowProtection || config.EnableHotRowProtectionDryRun,
+		hotRowQueueTimeout:     time.Duration(config.HotRowProtectionQueueTimeout * 1e9),
+		callerTimeouts:         make(map[string]time.Duration),
 		checkMySQLThrottler:    sync2.NewSemaphore(1, 0),
 		streamHealthMap:        make(map[int]chan<- *querypb.StreamHealthResponse),
 		history:                history.New(10),
@@ -215,6 +235,12 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	tsv.messager = messager.NewEngine(tsv, tsv.se, config)
 	tsv.watcher = NewReplicationWatcher(tsv.se, config)
 	tsv.updateStreamList = &binlog.StreamList{}
+	tsv.updateStreamAcks = newUpdateStreamAcks()
+	if timeouts, err := config.CallerQueryTimeoutMap(); err != nil {
+		log.Errorf("Ignoring caller query timeouts: %v", err)
+	} else {
+		tsv.callerTimeouts = timeouts
+	}
 	// FIXME(alainjobart) could we move this to the Register method below?
 	// So that vtcombo doesn't even call it once, on the first tablet.
 	// And we can remove the tsOnce variable.
@@ -228,6 +254,16 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 		stats.Publish("QueryTimeout", stats.DurationFunc(tsv.QueryTimeout.Get))
 		stats.Publish("BeginTimeout", stats.DurationFunc(tsv.BeginTimeout.Get))
 		stats.Publish("TabletStateName", stats.StringFunc(tsv.GetState))
+		stats.Publish("UpdateStreamAckPositions", stats.StringMapFunc(tsv.updateStreamAcks.Positions))
+		stats.Publish("UpdateStreamAckSecondsAgo", stats.CountersFunc(tsv.updateStreamAcks.SecondsSinceAck))
+		stats.Publish("MySQLReachable", stats.IntFunc(func() int64 {
+			if tsv.mysqlUnreachable.Get() {
+				return 0
+			}
+			return 1
+		}))
+		stats.Publish("CheckMySQLFailures", stats.IntFunc(tsv.checkMySQLFailures.Get))
+		stats.Publish("MySQLTimeSinceLastSuccess", stats.DurationFunc(tsv.timeSinceLastMySQLSuccess))
 	})
 	return tsv
 }
@@ -241,7 +277,12 @@ func (tsv *TabletServer) Register() {
 	tsv.registerDebugHealthHandler()
 	tsv.registerQueryzHandler()
 	tsv.registerStreamQueryzHandlers()
+	tsv.registerQueryKillHandler()
+	tsv.registerCallerQueryTimeoutHandler()
+	tsv.registerSchemaReloadTableHandler()
 	tsv.registerTwopczHandler()
+	tsv.registerTransactionsHandlers()
+	tsv.registerUpdateStreamAcksHandler()
 }
 
 // RegisterQueryRuleSource registers ruleSource for setting query rules.
@@ -443,6 +484,7 @@ func (tsv *TabletServer) fullStart() (err error) {
 		return err
 	}
 	c.Close()
+	tsv.setMySQLReachable(true)
 
 	if err := tsv.se.Open(); err != nil {
 		return err
@@ -584,6 +626,9 @@ func (tsv *TabletServer) IsHealthy() error {
 	tsv.mu.Unlock()
 	switch tabletType {
 	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_BATCH, topodatapb.TabletType_EXPERIMENTAL:
+		if tsv.mysqlUnreachable.Get() {
+			return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "MySQL is unreachable, last reached %v ago", tsv.timeSinceLastMySQLSuccess())
+		}
 		_, err := tsv.Execute(
 			tabletenv.LocalContext(),
 			nil,
@@ -599,8 +644,12 @@ func (tsv *TabletServer) IsHealthy() error {
 }
 
 // CheckMySQL initiates a check to see if MySQL is reachable.
-// If not, it shuts down the query service. The check is rate-limited
-// to no more than once per second.
+// If not, it shuts down the query service. Only one check runs
+// at a time, and no more than once per second: calls made while
+// a check is running or cooling down are dropped. The result of
+// the last check is exported as MySQLReachable, and the time since
+// MySQL was last reached as MySQLTimeSinceLastSuccess. IsHealthy
+// reports an error while MySQL is unreachable.
 func (tsv *TabletServer) CheckMySQL() {
 	if !tsv.checkMySQLThrottler.TryAcquire() {
 		return
@@ -611,14 +660,35 @@ func (tsv *TabletServer) CheckMySQL() {
 			time.Sleep(1 * time.Second)
 			tsv.checkMySQLThrottler.Release()
 		}()
-		if tsv.isMySQLReachable() {
+		reachable := tsv.isMySQLReachable()
+		tsv.setMySQLReachable(reachable)
+		if reachable {
 			return
 		}
+		tsv.checkMySQLFailures.Add(1)
 		log.Info("Check MySQL failed. Shutting down query service")
 		tsv.StopService()
 	}()
 }
 
+// setMySQLReachable records the result of a connection to MySQL.
+func (tsv *TabletServer) setMySQLReachable(reachable bool) {
+	tsv.mysqlUnreachable.Set(!reachable)
+	if reachable {
+		tsv.lastMySQLSuccess.Set(time.Now().UnixNano())
+	}
+}
+
+// timeSinceLastMySQLSuccess returns how long ago MySQL was last
+// known to be reachable, or 0 if it never was.
+func (tsv *TabletServer) timeSinceLastMySQLSuccess() time.Duration {
+	last := tsv.lastMySQLSuccess.Get()
+	if last == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, last))
+}
+
 // isMySQLReachable returns true if we can connect to MySQL.
 // The function returns false only if the query service is
 // in StateServing or StateNotServing.
@@ -651,6 +721,12 @@ func (tsv *TabletServer) ReloadSchema(ctx context.Context) error {
 	return nil
 }
 
+// ReloadSchemaTable reloads the schema of a single table, and returns
+// its new version. It returns an error if the table does not exist.
+func (tsv *TabletServer) ReloadSchemaTable(ctx context.Context, tableName string) (int64, error) {
+	return tsv.se.ReloadTable(ctx, tableName)
+}
+
 // ClearQueryPlanCache clears internal query plan cache
 func (tsv *TabletServer) ClearQueryPlanCache() {
 	// We should ideally bracket this with start & endErequest,
@@ -692,7 +768,7 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, opti
 // Commit commits the specified transaction.
 func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"Commit", "commit", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -706,7 +782,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 // Rollback rollsback the specified transaction.
 func (tsv *TabletServer) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"Rollback", "rollback", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -720,7 +796,7 @@ func (tsv *TabletServer) Rollback(ctx context.Context, target *querypb.Target, t
 // Prepare prepares the specified transaction.
 func (tsv *TabletServer) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"Prepare", "prepare", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -738,7 +814,7 @@ func (tsv *TabletServer) Prepare(ctx context.Context, target *querypb.Target, tr
 // CommitPrepared commits the prepared transaction.
 func (tsv *TabletServer) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"CommitPrepared", "commit_prepared", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -756,7 +832,7 @@ func (tsv *TabletServer) CommitPrepared(ctx context.Context, target *querypb.Tar
 // RollbackPrepared commits the prepared transaction.
 func (tsv *TabletServer) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"RollbackPrepared", "rollback_prepared", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -774,7 +850,7 @@ func (tsv *TabletServer) RollbackPrepared(ctx context.Context, target *querypb.T
 // CreateTransaction creates the metadata for a 2PC transaction.
 func (tsv *TabletServer) CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"CreateTransaction", "create_transaction", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -793,7 +869,7 @@ func (tsv *TabletServer) CreateTransaction(ctx context.Context, target *querypb.
 // decision to commit the associated 2pc transaction.
 func (tsv *TabletServer) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"StartCommit", "start_commit", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -812,7 +888,7 @@ func (tsv *TabletServer) StartCommit(ctx context.Context, target *querypb.Target
 // If a transaction id is provided, that transaction is also rolled back.
 func (tsv *TabletServer) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"SetRollback", "set_rollback", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -831,7 +907,7 @@ func (tsv *TabletServer) SetRollback(ctx context.Context, target *querypb.Target
 // essentially resolving it.
 func (tsv *TabletServer) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) (err error) {
 	return tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"ConcludeTransaction", "conclude_transaction", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -849,7 +925,7 @@ func (tsv *TabletServer) ConcludeTransaction(ctx context.Context, target *queryp
 // ReadTransaction returns the metadata for the sepcified dtid.
 func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (metadata *querypb.TransactionMetadata, err error) {
 	err = tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"ReadTransaction", "read_transaction", nil,
 		target, nil, true, true,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -870,7 +946,7 @@ func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Ta
 func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, err error) {
 	allowOnShutdown := (transactionID != 0)
 	err = tsv.execRequest(
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"Execute", sql, bindVariables,
 		target, options, false, allowOnShutdown,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -878,6 +954,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
 			query, comments := sqlparser.SplitTrailingComments(sql)
+			query = tsv.qe.NormalizeINLists(query, bindVariables)
 			plan, err := tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options))
 			if err != nil {
 				return err
@@ -911,7 +988,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 // The subsequent QueryResult will have Rows set (and Fields nil).
 func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
-		ctx, 0,
+		ctx, tsv.streamTimeout(ctx),
 		"StreamExecute", sql, bindVariables,
 		target, options, false, false,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -1047,7 +1124,7 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 	err := tsv.execRequest(
 		// Use (potentially longer) -queryserver-config-query-timeout and not
 		// -queryserver-config-txpool-timeout (defaults to 1s) to limit the waiting.
-		ctx, tsv.QueryTimeout.Get(),
+		ctx, tsv.queryTimeout(ctx),
 		"", "waitForSameRangeTransactions", nil,
 		target, options, true /* isTx */, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -1057,12 +1134,25 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 				return nil
 			}
 
+			waitCtx := ctx
+			if tsv.hotRowQueueTimeout > 0 {
+				var cancel context.CancelFunc
+				waitCtx, cancel = context.WithTimeout(ctx, tsv.hotRowQueueTimeout)
+				defer cancel()
+			}
+
 			startTime := time.Now()
-			done, waited, waitErr := tsv.qe.txSerializer.Wait(ctx, k, table)
+			done, waited, waitErr := tsv.qe.txSerializer.Wait(waitCtx, k, table)
 			txDone = done
 			if waited {
 				tabletenv.WaitStats.Record("TxSerializer", startTime)
 			}
+			if waitErr != nil && ctx.Err() == nil && waitCtx.Err() != nil {
+				// Only the queue timeout expired and not the request itself.
+				tsv.qe.txSerializer.RecordQueueTimeout(table)
+				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+					"hot row protection: timed out after %v waiting in the queue for the same row (table + WHERE clause: '%v')", tsv.hotRowQueueTimeout, k)
+			}
 
 			return waitErr
 		})
@@ -1223,7 +1313,7 @@ func (tsv *TabletServer) SplitQuery(
 	algorithm querypb.SplitQueryRequest_Algorithm,
 ) (splits []*querypb.QuerySplit, err error) {
 	err = tsv.execRequest(
-		ctx, 0,
+		ctx, tsv.streamTimeout(ctx),
 		"SplitQuery", query.Sql, query.BindVariables,
 		target, nil, false, false,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -1354,6 +1444,16 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 
 	origErr := err
 	err = formatErrorWithCallerID(ctx, vterrors.New(errCode, err.Error()))
+
+	// If queries are redacted, the MySQL error message is stripped before
+	// the error is logged, because it may contain values from the query.
+	redact := *streamlog.RedactDebugUIQueries && errCode != vtrpcpb.Code_FAILED_PRECONDITION
+	if redact {
+		if sqlErr, ok := origErr.(*mysql.SQLError); ok {
+			err = terseSQLError(ctx, errCode, sqlErr, tabletenv.RedactSQL(sql))
+		}
+	}
+
 	if logMethod != nil {
 		// In order to correctly truncate long queries in logs, combine
 		// the error (which contains both the mysql error string and the
@@ -1381,13 +1481,10 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	// 2. FAILED_PRECONDITION errors. These are caused when a failover is in progress.
 	// If so, we don't want to suppress the error. This will allow VTGate to
 	// detect and perform buffering during failovers.
-	if tsv.TerseErrors && len(bindVariables) != 0 && errCode != vtrpcpb.Code_FAILED_PRECONDITION {
+	if !redact && tsv.TerseErrors && len(bindVariables) != 0 && errCode != vtrpcpb.Code_FAILED_PRECONDITION {
 		sqlErr, ok := origErr.(*mysql.SQLError)
 		if ok {
-			sqlState := sqlErr.SQLState()
-			errnum := sqlErr.Number()
-			err = vterrors.Errorf(errCode, "(errno %d) (sqlstate %s) during query: %s", errnum, sqlState, sqlparser.TruncateForLog(sql))
-			err = formatErrorWithCallerID(ctx, err)
+			err = terseSQLError(ctx, errCode, sqlErr, sql)
 		}
 	}
 
@@ -1398,6 +1495,13 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	return err
 }
 
+// terseSQLError returns an error that only keeps the error number and
+// sql state of sqlErr.
+func terseSQLError(ctx context.Context, errCode vtrpcpb.Code, sqlErr *mysql.SQLError, sql string) error {
+	err := vterrors.Errorf(errCode, "(errno %d) (sqlstate %s) during query: %s", sqlErr.Number(), sqlErr.SQLState(), sqlparser.TruncateForLog(sql))
+	return formatErrorWithCallerID(ctx, err)
+}
+
 func formatErrorWithCallerID(ctx context.Context, err error) error {
 	if err == nil {
 		return nil
@@ -1426,7 +1530,9 @@ func (tsv *TabletServer) convertErrorCode(err error) vtrpcpb.Code {
 		errCode = vtrpcpb.Code_RESOURCE_EXHAUSTED
 	case mysql.ERLockWaitTimeout:
 		errCode = vtrpcpb.Code_DEADLINE_EXCEEDED
-	case mysql.CRServerGone, mysql.ERServerShutdown:
+	case mysql.CRConnectionError, mysql.CRConnHostError, mysql.CRServerGone, mysql.ERServerShutdown:
+		// The connection to mysqld could not be made or was dropped.
+		// These are also reported by mysql.IsConnErr.
 		errCode = vtrpcpb.Code_UNAVAILABLE
 	case mysql.ERFormNotFound, mysql.ERKeyNotFound, mysql.ERBadFieldError, mysql.ERNoSuchThread, mysql.ERUnknownTable, mysql.ERCantFindUDF, mysql.ERNonExistingGrant,
 		mysql.ERNoSuchTable, mysql.ERNonExistingTableGrant, mysql.ERKeyDoesNotExist:
@@ -1762,6 +1868,37 @@ func (tsv *TabletServer) UpdateStream(ctx context.Context, target *querypb.Targe
 	}
 }
 
+// UpdateStreamAck records the position an UpdateStream consumer has
+// applied. The last ack of every consumer is exported in the stats and
+// on /debug/update_stream_acks.
+func (tsv *TabletServer) UpdateStreamAck(ctx context.Context, target *querypb.Target, consumer, position string) error {
+	if consumer == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer must be specified")
+	}
+	if _, err := mysql.DecodePosition(position); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot parse position: %v", err)
+	}
+	if err := tsv.startRequest(ctx, target, false, false); err != nil {
+		return err
+	}
+	defer tsv.endRequest(false)
+	tsv.updateStreamAcks.Record(consumer, position, time.Now())
+	return nil
+}
+
+// WaitForReplicationPosition waits until the replication stream of
+// this tablet has reached the specified position, or the context
+// expires. Reads sent to this tablet after it returns will see all
+// the changes up to that position. It requires the replication
+// stream to be watched.
+func (tsv *TabletServer) WaitForReplicationPosition(ctx context.Context, target *querypb.Target, position string) error {
+	if err := tsv.startRequest(ctx, target, false, false); err != nil {
+		return err
+	}
+	defer tsv.endRequest(false)
+	return tsv.watcher.WaitForPosition(ctx, position)
+}
+
 // HandlePanic is part of the queryservice.QueryService interface
 func (tsv *TabletServer) HandlePanic(err *error) {
 	if x := recover(); x != nil {
@@ -1859,6 +1996,85 @@ func (tsv *TabletServer) registerQueryzHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerQueryKillHandler() {
+	http.HandleFunc("/debug/queries/kill", func(w http.ResponseWriter, r *http.Request) {
+		queriesKillHandler(tsv, w, r)
+	})
+}
+
+func (tsv *TabletServer) registerSchemaReloadTableHandler() {
+	http.HandleFunc("/debug/schema/reload_table", func(w http.ResponseWriter, r *http.Request) {
+		schemaReloadTableHandler(tsv, w, r)
+	})
+}
+
+// schemaReloadTableHandler reloads the schema of a single table.
+// Endpoint: /debug/schema/reload_table?table=<table name>
+func schemaReloadTableHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	tableName := r.FormValue("table")
+	if tableName == "" {
+		http.Error(w, "missing table", http.StatusBadRequest)
+		return
+	}
+	version, err := tsv.ReloadSchemaTable(tabletenv.LocalContext(), tableName)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if vterrors.Code(err) == vtrpcpb.Code_NOT_FOUND {
+			status = http.StatusNotFound
+		}
+		http.Error(w, fmt.Sprintf("error: %v", err), status)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintf(w, "reloaded table %s, version %d\n", tableName, version)
+}
+
+func (tsv *TabletServer) registerCallerQueryTimeoutHandler() {
+	http.HandleFunc("/debug/caller_query_timeout", func(w http.ResponseWriter, r *http.Request) {
+		callerQueryTimeoutHandler(tsv, w, r)
+	})
+}
+
+// callerQueryTimeoutHandler sets the query timeout of a caller.
+// A timeout of 0 removes the restriction.
+// Endpoint: /debug/caller_query_timeout?caller=<username>&timeout=<seconds>
+func callerQueryTimeoutHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	caller := r.FormValue("caller")
+	if caller == "" {
+		http.Error(w, "missing caller", http.StatusBadRequest)
+		return
+	}
+	seconds, err := strconv.ParseFloat(r.FormValue("timeout"), 64)
+	if err != nil || seconds < 0 {
+		http.Error(w, fmt.Sprintf("invalid timeout: %q", r.FormValue("timeout")), http.StatusBadRequest)
+		return
+	}
+	timeout := time.Duration(seconds * 1e9)
+	tsv.SetCallerQueryTimeout(caller, timeout)
+	w.Header().Set("Content-Type", "text/plain")
+	if timeout == 0 {
+		fmt.Fprintf(w, "removed query timeout of %s\n", caller)
+		return
+	}
+	fmt.Fprintf(w, "set query timeout of %s to %v\n", caller, timeout)
+}
+
 func (tsv *TabletServer) registerStreamQueryzHandlers() {
 	http.HandleFunc("/streamqueryz", func(w http.ResponseWriter, r *http.Request) {
 		streamQueryzHandler(tsv.qe.streamQList, w, r)
@@ -1868,6 +2084,21 @@ func (tsv *TabletServer) registerStreamQueryzHandlers() {
 	})
 }
 
+func (tsv *TabletServer) registerTransactionsHandlers() {
+	http.HandleFunc("/debug/transactions", func(w http.ResponseWriter, r *http.Request) {
+		transactionsHandler(tsv.te.txPool, w, r)
+	})
+	http.HandleFunc("/debug/transactions/kill", func(w http.ResponseWriter, r *http.Request) {
+		transactionsKillHandler(tsv.te.txPool, w, r)
+	})
+}
+
+func (tsv *TabletServer) registerUpdateStreamAcksHandler() {
+	http.HandleFunc("/debug/update_stream_acks", func(w http.ResponseWriter, r *http.Request) {
+		updateStreamAcksHandler(tsv.updateStreamAcks, w, r)
+	})
+}
+
 func (tsv *TabletServer) registerTwopczHandler() {
 	http.HandleFunc("/twopcz", func(w http.ResponseWriter, r *http.Request) {
 		ctx := tabletenv.LocalContext()
@@ -1925,6 +2156,57 @@ func (tsv *TabletServer) TxTimeout() time.Duration {
 	return tsv.te.txPool.Timeout()
 }
 
+// SetCallerQueryTimeout restricts the queries of the caller to the
+// specified timeout. It only takes effect if it's lower than QueryTimeout.
+// A zero timeout removes the restriction.
+func (tsv *TabletServer) SetCallerQueryTimeout(username string, timeout time.Duration) {
+	tsv.callerMu.Lock()
+	defer tsv.callerMu.Unlock()
+	if timeout == 0 {
+		delete(tsv.callerTimeouts, username)
+		return
+	}
+	tsv.callerTimeouts[username] = timeout
+}
+
+// callerQueryTimeout returns the timeout of the caller of ctx,
+// and true if it's lower than QueryTimeout.
+func (tsv *TabletServer) callerQueryTimeout(ctx context.Context) (time.Duration, bool) {
+	username := tabletenv.CallerName(ctx)
+	tsv.callerMu.Lock()
+	timeout, ok := tsv.callerTimeouts[username]
+	tsv.callerMu.Unlock()
+	if !ok {
+		return 0, false
+	}
+	if qt := tsv.QueryTimeout.Get(); qt != 0 && qt <= timeout {
+		return 0, false
+	}
+	return timeout, true
+}
+
+// queryTimeout returns the timeout to use for a query of the caller of ctx.
+func (tsv *TabletServer) queryTimeout(ctx context.Context) time.Duration {
+	if timeout, ok := tsv.callerQueryTimeout(ctx); ok {
+		return timeout
+	}
+	return tsv.QueryTimeout.Get()
+}
+
+// streamTimeout returns the timeout to use for a streaming query of the
+// caller of ctx. Streaming queries are not subject to QueryTimeout, so it's
+// 0 unless the caller is restricted.
+func (tsv *TabletServer) streamTimeout(ctx context.Context) time.Duration {
+	timeout, _ := tsv.callerQueryTimeout(ctx)
+	return timeout
+}
+
+// TerminateCallerQueries kills all the queries of the caller that are
+// running on MySQL, and returns the number of queries killed.
+func (tsv *TabletServer) TerminateCallerQueries(username string) int {
+	return tsv.qe.qList.TerminateCaller(username) + tsv.qe.streamQList.TerminateCaller(username)
+}
+
 // SetQueryPlanCacheCap changes the pool size to the specified value.
 // This function should only be used for testing.
 func (tsv *TabletServer) SetQueryPlanCacheCap(val int) {
@@ -1978,6 +2260,9 @@ func (tsv *TabletServer) MaxDMLRows() int {
 // queryAsString prints a readable version of query+bind variables,
 // and also truncates data if it's too long
 func queryAsString(sql string, bindVariables map[string]*querypb.BindVariable) string {
+	if *streamlog.RedactDebugUIQueries {
+		return fmt.Sprintf("Sql: %q, BindVars: [REDACTED]", tabletenv.RedactSQL(sql))
+	}
 	buf := &bytes.Buffer{}
 	fmt.Fprintf(buf, "Sql: %q, BindVars: {", sql)
 	for k, v := range bindVariables {
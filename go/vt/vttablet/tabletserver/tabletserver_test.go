@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strconv"
 	"strings"
@@ -35,6 +37,8 @@ import (
 	"github.com/youtube/vitess/go/mysql"
 	"github.com/youtube/vitess/go/mysql/fakesqldb"
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/streamlog"
+	"github.com/youtube/vitess/go/vt/callerid"
 	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -375,6 +379,57 @@ func TestTabletServerAllSchemaFailure(t *testing.T) {
 	}
 }
 
+func TestTabletServerReloadSchemaTable(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	db.AddQuery(mysql.BaseShowTablesForTable("missing_table"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+	})
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	version, err := tsv.ReloadSchemaTable(context.Background(), "test_table")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tsv.se.GetTable(sqlparser.NewTableIdent("test_table")).Version; got != version {
+		t.Errorf("test_table version: %d, want %d", got, version)
+	}
+
+	testcases := []struct {
+		url    string
+		status int
+		body   string
+	}{{
+		url:    "/debug/schema/reload_table?table=test_table",
+		status: http.StatusOK,
+		body:   fmt.Sprintf("reloaded table test_table, version %d\n", version+1),
+	}, {
+		url:    "/debug/schema/reload_table?table=missing_table",
+		status: http.StatusNotFound,
+		body:   "error: ReloadTable: table missing_table not found in MySQL\n",
+	}, {
+		url:    "/debug/schema/reload_table",
+		status: http.StatusBadRequest,
+		body:   "missing table\n",
+	}}
+	for _, tc := range testcases {
+		req, _ := http.NewRequest("GET", tc.url, nil)
+		resp := httptest.NewRecorder()
+		schemaReloadTableHandler(tsv, resp, req)
+		if resp.Code != tc.status || resp.Body.String() != tc.body {
+			t.Errorf("%s: %d %q, want %d %q", tc.url, resp.Code, resp.Body.String(), tc.status, tc.body)
+		}
+	}
+}
+
 func TestTabletServerCheckMysql(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -424,6 +479,72 @@ func TestTabletServerCheckMysqlFailInvalidConn(t *testing.T) {
 	}
 }
 
+func TestTabletServerCheckMysqlRecordsFailure(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbcfgs)
+	defer tsv.StopService()
+	if err != nil {
+		t.Fatalf("TabletServer.StartService should succeed, but got error: %v", err)
+	}
+	if tsv.mysqlUnreachable.Get() {
+		t.Errorf("mysqlUnreachable: true, want false")
+	}
+	// make mysql conn fail
+	db.Close()
+	tsv.CheckMySQL()
+	for i := 0; tsv.checkMySQLFailures.Get() == 0; i++ {
+		if i == 100 {
+			t.Fatalf("CheckMySQL did not record a failure")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !tsv.mysqlUnreachable.Get() {
+		t.Errorf("mysqlUnreachable: false, want true")
+	}
+	if got := tsv.timeSinceLastMySQLSuccess(); got <= 0 {
+		t.Errorf("timeSinceLastMySQLSuccess: %v, want > 0", got)
+	}
+}
+
+func TestTabletServerIsHealthyMySQLUnreachable(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	err := tsv.StartService(target, dbcfgs)
+	defer tsv.StopService()
+	if err != nil {
+		t.Fatalf("TabletServer.StartService should succeed, but got error: %v", err)
+	}
+	if got := tsv.timeSinceLastMySQLSuccess(); got <= 0 || got > time.Minute {
+		t.Errorf("timeSinceLastMySQLSuccess: %v, want the time since StartService", got)
+	}
+	db.AddQuery("select 1 from dual where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select 1 from dual limit 10001", &sqltypes.Result{})
+	if err := tsv.IsHealthy(); err != nil {
+		t.Errorf("IsHealthy: %v, want nil", err)
+	}
+
+	tsv.setMySQLReachable(false)
+	want := "MySQL is unreachable"
+	if err := tsv.IsHealthy(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("IsHealthy: %v, must contain %s", err, want)
+	}
+	tsv.setMySQLReachable(true)
+	if err := tsv.IsHealthy(); err != nil {
+		t.Errorf("IsHealthy: %v, want nil", err)
+	}
+}
+
 func TestTabletServerCheckMysqlInUnintialized(t *testing.T) {
 	testUtils := newTestUtils()
 	config := testUtils.newQueryServiceConfig()
@@ -1182,6 +1303,47 @@ func TestTabletServerStreamExecute(t *testing.T) {
 	}
 }
 
+func TestTabletServerWaitForReplicationPosition(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+	pos := "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5"
+
+	err := tsv.WaitForReplicationPosition(ctx, &target, pos)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("WaitForReplicationPosition without watcher: %v, want FAILED_PRECONDITION", err)
+	}
+
+	// Simulate a watched stream which has reached the position.
+	tsv.watcher.watchReplication = true
+	tsv.watcher.processTransaction(ctx, &querypb.EventToken{Position: pos}, nil)
+	if err := tsv.WaitForReplicationPosition(ctx, &target, pos); err != nil {
+		t.Errorf("WaitForReplicationPosition: %v", err)
+	}
+
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = tsv.WaitForReplicationPosition(shortCtx, &target, "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10")
+	if code := vterrors.Code(err); code != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("WaitForReplicationPosition: %v, want DEADLINE_EXCEEDED", err)
+	}
+
+	badTarget := querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	err = tsv.WaitForReplicationPosition(ctx, &badTarget, pos)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("WaitForReplicationPosition(REPLICA): %v, want FAILED_PRECONDITION", err)
+	}
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -1940,6 +2102,84 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 	}
 }
 
+// TestSerializeTransactionsSameRow_QueueTimeout tests that a transaction which
+// waits longer than -hot_row_protection_queue_timeout for a hot row is
+// rejected while the hot row is still locked by another transaction.
+func TestSerializeTransactionsSameRow_QueueTimeout(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.EnableHotRowProtection = true
+	config.HotRowProtectionConcurrentTransactions = 1
+	config.HotRowProtectionQueueTimeout = 0.01
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	// Fake data.
+	q1 := "update test_table set name_string = 'tx1' where pk = :pk and name = :name"
+	q2 := "update test_table set name_string = 'tx2' where pk = :pk and name = :name"
+	// Every request needs their own bind variables to avoid data races.
+	bvTx1 := map[string]*querypb.BindVariable{
+		"pk":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.Int64BindVariable(1),
+	}
+	bvTx2 := map[string]*querypb.BindVariable{
+		"pk":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.Int64BindVariable(1),
+	}
+
+	// Make sure that tx2 starts only after tx1 is running its Execute().
+	tx1Started := make(chan struct{})
+	// Signal when tx2 is done.
+	tx2Failed := make(chan struct{})
+
+	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk in (1) /* _stream test_table (pk ) (1 ); */",
+		func() {
+			close(tx1Started)
+			<-tx2Failed
+		})
+
+	ctx := context.Background()
+	wg := sync.WaitGroup{}
+
+	// tx1.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		_, tx1, err := tsv.BeginExecute(ctx, &target, q1, bvTx1, nil)
+		if err != nil {
+			t.Errorf("failed to execute query: %s: %s", q1, err)
+			return
+		}
+		if err := tsv.Commit(ctx, &target, tx1); err != nil {
+			t.Errorf("call TabletServer.Commit failed: %v", err)
+		}
+	}()
+
+	// tx2.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(tx2Failed)
+
+		<-tx1Started
+		_, _, err := tsv.BeginExecute(ctx, &target, q2, bvTx2, nil)
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: timed out after 10ms waiting in the queue for the same row (table + WHERE clause: 'test_table where pk = 1 and name = 1')" {
+			t.Errorf("tx2 should have failed because it waited too long in the queue: %v", err)
+		}
+		// No commit necessary because the Begin failed.
+	}()
+
+	wg.Wait()
+}
+
 // TestSerializeTransactionsSameRow_TooManyPendingRequests_ExecuteBatchAsTransaction
 // tests the same thing as TestSerializeTransactionsSameRow_TooManyPendingRequests
 // but for the ExecuteBatch method with asTransaction=true.
@@ -2187,6 +2427,50 @@ func TestMessageStream(t *testing.T) {
 	}
 }
 
+func TestUpdateStreamAck(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	testcases := []struct {
+		consumer, position, err string
+	}{{
+		position: "MariaDB/0-1-1",
+		err:      "consumer must be specified",
+	}, {
+		consumer: "c1",
+		position: "bad",
+		err:      "cannot parse position",
+	}, {
+		consumer: "c1",
+		position: "MariaDB/0-1-1",
+	}, {
+		consumer: "c1",
+		position: "MariaDB/0-1-2",
+	}}
+	for _, tcase := range testcases {
+		err := tsv.UpdateStreamAck(ctx, &target, tcase.consumer, tcase.position)
+		if tcase.err == "" {
+			if err != nil {
+				t.Errorf("UpdateStreamAck(%q, %q): %v", tcase.consumer, tcase.position, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.err) {
+			t.Errorf("UpdateStreamAck(%q, %q): %v, want %s", tcase.consumer, tcase.position, err, tcase.err)
+		}
+		if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+			t.Errorf("UpdateStreamAck(%q, %q): %v, want %v", tcase.consumer, tcase.position, code, vtrpcpb.Code_INVALID_ARGUMENT)
+		}
+	}
+	want := map[string]string{"c1": "MariaDB/0-1-2"}
+	if got := tsv.updateStreamAcks.Positions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("acked positions: %v, want %v", got, want)
+	}
+}
+
 func TestMessageAck(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -2570,6 +2854,172 @@ func TestHandleExecTabletError(t *testing.T) {
 	}
 }
 
+func TestConvertErrorCode(t *testing.T) {
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	testcases := []struct {
+		errnum  int
+		message string
+		want    vtrpcpb.Code
+	}{{
+		errnum: mysql.ERDupEntry,
+		want:   vtrpcpb.Code_ALREADY_EXISTS,
+	}, {
+		errnum: mysql.ERLockDeadlock,
+		want:   vtrpcpb.Code_ABORTED,
+	}, {
+		errnum: mysql.ERLockWaitTimeout,
+		want:   vtrpcpb.Code_DEADLINE_EXCEEDED,
+	}, {
+		errnum: mysql.CRConnectionError,
+		want:   vtrpcpb.Code_UNAVAILABLE,
+	}, {
+		errnum: mysql.CRConnHostError,
+		want:   vtrpcpb.Code_UNAVAILABLE,
+	}, {
+		errnum: mysql.CRServerGone,
+		want:   vtrpcpb.Code_UNAVAILABLE,
+	}, {
+		errnum: mysql.CRServerLost,
+		want:   vtrpcpb.Code_DEADLINE_EXCEEDED,
+	}, {
+		errnum:  mysql.EROptionPreventsStatement,
+		message: "The MySQL server is running with the --read-only option so it cannot execute this statement",
+		want:    vtrpcpb.Code_FAILED_PRECONDITION,
+	}, {
+		errnum:  mysql.EROptionPreventsStatement,
+		message: "some other option",
+		want:    vtrpcpb.Code_UNKNOWN,
+	}, {
+		errnum: mysql.ERSyntaxError,
+		want:   vtrpcpb.Code_INVALID_ARGUMENT,
+	}}
+	for _, tcase := range testcases {
+		err := mysql.NewSQLError(tcase.errnum, mysql.SSUnknownSQLState, "%s", tcase.message)
+		if got := tsv.convertErrorCode(err); got != tcase.want {
+			t.Errorf("convertErrorCode(%d, %q): %v, want %v", tcase.errnum, tcase.message, got, tcase.want)
+		}
+	}
+}
+
+func TestTabletServerCallerQueryTimeout(t *testing.T) {
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.QueryTimeout = 1
+	tsv := NewTabletServerWithNilTopoServer(config)
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+
+	testcases := []struct {
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{100 * time.Millisecond, 100 * time.Millisecond},
+		// A caller timeout can't raise the global one.
+		{2 * time.Second, time.Second},
+	}
+	for _, tcase := range testcases {
+		tsv.SetCallerQueryTimeout("u1", tcase.timeout)
+		if got := tsv.queryTimeout(ctx); got != tcase.want {
+			t.Errorf("queryTimeout with caller timeout %v: %v, want %v", tcase.timeout, got, tcase.want)
+		}
+		if got := tsv.queryTimeout(context.Background()); got != time.Second {
+			t.Errorf("queryTimeout without caller: %v, want %v", got, time.Second)
+		}
+	}
+
+	// Streaming queries only have a timeout if the caller is restricted.
+	tsv.SetCallerQueryTimeout("u1", 100*time.Millisecond)
+	if got, want := tsv.streamTimeout(ctx), 100*time.Millisecond; got != want {
+		t.Errorf("streamTimeout: %v, want %v", got, want)
+	}
+	if got := tsv.streamTimeout(context.Background()); got != 0 {
+		t.Errorf("streamTimeout without caller: %v, want 0", got)
+	}
+
+	// Caller timeouts can be configured by flag.
+	config.CallerQueryTimeouts = map[string]string{"u1": "0.2"}
+	tsv = NewTabletServerWithNilTopoServer(config)
+	if got, want := tsv.queryTimeout(ctx), 200*time.Millisecond; got != want {
+		t.Errorf("queryTimeout with configured caller timeout: %v, want %v", got, want)
+	}
+}
+
+func TestTabletServerStreamExecuteCallerQueryTimeout(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+	})
+	db.SetBeforeFunc(executeSQL, func() { time.Sleep(100 * time.Millisecond) })
+
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	tsv.SetCallerQueryTimeout("u1", 10*time.Millisecond)
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	callback := func(*sqltypes.Result) error { return nil }
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, nil, callback)
+	want := "context deadline exceeded"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("StreamExecute of restricted caller: %v, must contain %s", err, want)
+	}
+}
+
+func TestCallerQueryTimeoutHandler(t *testing.T) {
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.QueryTimeout = 1
+	tsv := NewTabletServerWithNilTopoServer(config)
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+
+	testcases := []struct {
+		url     string
+		status  int
+		body    string
+		timeout time.Duration
+	}{{
+		url:     "/debug/caller_query_timeout?caller=u1&timeout=0.1",
+		status:  http.StatusOK,
+		body:    "set query timeout of u1 to 100ms\n",
+		timeout: 100 * time.Millisecond,
+	}, {
+		url:     "/debug/caller_query_timeout?caller=u1&timeout=abc",
+		status:  http.StatusBadRequest,
+		body:    "invalid timeout: \"abc\"\n",
+		timeout: 100 * time.Millisecond,
+	}, {
+		url:     "/debug/caller_query_timeout?timeout=0.1",
+		status:  http.StatusBadRequest,
+		body:    "missing caller\n",
+		timeout: 100 * time.Millisecond,
+	}, {
+		url:     "/debug/caller_query_timeout?caller=u1&timeout=0",
+		status:  http.StatusOK,
+		body:    "removed query timeout of u1\n",
+		timeout: time.Second,
+	}}
+	for _, tc := range testcases {
+		req, _ := http.NewRequest("GET", tc.url, nil)
+		resp := httptest.NewRecorder()
+		callerQueryTimeoutHandler(tsv, resp, req)
+		if resp.Code != tc.status || resp.Body.String() != tc.body {
+			t.Errorf("%s: %d %q, want %d %q", tc.url, resp.Code, resp.Body.String(), tc.status, tc.body)
+		}
+		if got := tsv.queryTimeout(ctx); got != tc.timeout {
+			t.Errorf("%s: queryTimeout: %v, want %v", tc.url, got, tc.timeout)
+		}
+	}
+}
+
 func TestTerseErrorsNonSQLError(t *testing.T) {
 	ctx := context.Background()
 	testUtils := newTestUtils()
@@ -2624,6 +3074,45 @@ func TestTerseErrorsBindVars(t *testing.T) {
 	}
 }
 
+func TestRedactedErrors(t *testing.T) {
+	ctx := context.Background()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	setupTestLogger()
+	defer clearTestLogger()
+	*streamlog.RedactDebugUIQueries = true
+	defer func() {
+		*streamlog.RedactDebugUIQueries = false
+	}()
+
+	sqlErr := mysql.NewSQLError(mysql.ERDupEntry, "23000", "Duplicate entry 'secret-pk' for key 'PRIMARY'")
+	err := tsv.convertAndLogError(
+		ctx,
+		"insert into test_table(pk, name) values ('secret-pk', :name)",
+		map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("secret-name")},
+		sqlErr,
+		nil,
+	)
+	want := "(errno 1062) (sqlstate 23000) during query: insert into test_table(pk, name) values (:redacted1, :name)"
+	if err == nil || err.Error() != want {
+		t.Errorf("%v, want '%s'", err, want)
+	}
+
+	err = tsv.convertAndLogError(
+		ctx,
+		"select * from test_table where name = 'secret-name'",
+		nil,
+		vterrors.Errorf(vtrpcpb.Code_INTERNAL, "tablet error"),
+		nil,
+	)
+	for i, msg := range []string{err.Error(), getTestLog(0), getTestLog(1)} {
+		if strings.Contains(msg, "secret") {
+			t.Errorf("message %d contains unredacted values: %s", i, msg)
+		}
+	}
+}
+
 func TestTerseErrorsNoBindVars(t *testing.T) {
 	ctx := context.Background()
 	testUtils := newTestUtils()
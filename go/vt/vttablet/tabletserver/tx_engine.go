@@ -23,6 +23,7 @@ import (
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
 
+	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/timer"
 	"github.com/youtube/vitess/go/vt/concurrency"
 	"github.com/youtube/vitess/go/vt/dbconfigs"
@@ -54,6 +55,8 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 	te := &TxEngine{
 		shutdownGracePeriod: time.Duration(config.TxShutDownGracePeriod * 1e9),
 	}
+	// The overrides were validated by tabletenv.VerifyConfig.
+	overrides, _ := config.TransactionLimitOverrides()
 	limiter := txlimiter.New(
 		config.TransactionCap,
 		config.TransactionLimitPerUser,
@@ -63,6 +66,7 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 		config.TransactionLimitByPrincipal,
 		config.TransactionLimitByComponent,
 		config.TransactionLimitBySubcomponent,
+		overrides,
 	)
 	te.txPool = NewTxPool(
 		config.PoolNamePrefix,
@@ -73,6 +77,7 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 		checker,
 		limiter,
 	)
+	te.txPool.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
 		if config.TwoPCCoordinatorAddress == "" {
@@ -100,6 +105,7 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 		time.Duration(config.IdleTimeout*1e9),
 		checker,
 	)
+	readPool.SetMaxLifetime(time.Duration(config.ConnMaxLifetime * 1e9))
 	te.twoPC = NewTwoPC(readPool)
 	return te
 }
@@ -142,6 +148,18 @@ func (te *TxEngine) Open() {
 	te.isOpen = true
 }
 
+// shutdownProgressInterval is how often Close logs the number
+// of transactions it's still waiting for.
+var shutdownProgressInterval = 5 * time.Second
+
+var (
+	// shutdownTransactions is the number of transactions a graceful
+	// Close is waiting for, and shutdownTimeLeft is the time left
+	// before they're rolled back. Both are 0 outside of Close.
+	shutdownTransactions = stats.NewInt("ShutdownTransactionsRemaining")
+	shutdownTimeLeft     = stats.NewDuration("ShutdownGracePeriodLeft")
+)
+
 // Close closes the TxEngine. If the immediate flag is on,
 // then all current transactions are immediately rolled back.
 // Otherwise, the function waits for all current transactions
@@ -173,21 +191,46 @@ func (te *TxEngine) Close(immediate bool) {
 			te.rollbackTransactions()
 			return
 		}
+		// A nil deadline never fires.
+		var deadline <-chan time.Time
+		var deadlineTime time.Time
 		if te.shutdownGracePeriod <= 0 {
 			// No grace period was specified. Never rollback.
 			log.Info("No grace period specified: performing normal wait.")
-			return
+		} else {
+			tmr := time.NewTimer(te.shutdownGracePeriod)
+			defer tmr.Stop()
+			deadline = tmr.C
+			deadlineTime = time.Now().Add(te.shutdownGracePeriod)
 		}
-		tmr := time.NewTimer(te.shutdownGracePeriod)
-		defer tmr.Stop()
-		select {
-		case <-tmr.C:
-			// The grace period has passed. Rollback, but don't touch the 2pc transactions.
-			log.Info("Grace period exceeded: rolling back non-2pc transactions now.")
-			te.txPool.RollbackNonBusy(tabletenv.LocalContext())
-		case <-poolEmpty:
-			// The pool cleared before the timer kicked in. Just return.
-			log.Info("Transactions completed before grace period: shutting down.")
+		updateProgress := func() {
+			shutdownTransactions.Set(int64(te.txPool.activePool.Size()))
+			if deadline != nil {
+				shutdownTimeLeft.Set(deadlineTime.Sub(time.Now()))
+			}
+		}
+		updateProgress()
+		progress := time.NewTicker(shutdownProgressInterval)
+		defer progress.Stop()
+		for {
+			select {
+			case <-deadline:
+				// The grace period has passed. Rollback, but don't touch the 2pc transactions.
+				log.Info("Grace period exceeded: rolling back non-2pc transactions now.")
+				te.txPool.RollbackNonBusy(tabletenv.LocalContext())
+				return
+			case <-poolEmpty:
+				// The pool cleared before the timer kicked in. Just return.
+				log.Info("Transactions completed before grace period: shutting down.")
+				return
+			case <-progress.C:
+				updateProgress()
+				if deadline == nil {
+					log.Infof("Waiting for %d transactions to complete.", shutdownTransactions.Get())
+				} else {
+					log.Infof("Waiting for %d transactions to complete, %v left before rolling them back.", shutdownTransactions.Get(), shutdownTimeLeft.Get())
+				}
+			}
 		}
 	}()
 	te.txPool.WaitForEmpty()
@@ -195,6 +238,8 @@ func (te *TxEngine) Close(immediate bool) {
 	close(poolEmpty)
 	// Make sure the goroutine has returned.
 	<-rollbackDone
+	shutdownTransactions.Set(0)
+	shutdownTimeLeft.Set(0)
 
 	te.txPool.Close()
 	te.twoPC.Close()
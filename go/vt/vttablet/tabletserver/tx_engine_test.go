@@ -134,3 +134,69 @@ func TestTxEngineClose(t *testing.T) {
 		t.Errorf("Close time: %v, must be over 0.1", diff)
 	}
 }
+
+func TestTxEngineConnMaxLifetime(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.ConnMaxLifetime = 60
+	te := NewTxEngine(nil, config)
+	for name, pool := range map[string]interface {
+		MaxLifetime() time.Duration
+	}{
+		"txPool":        te.txPool.conns,
+		"foundRowsPool": te.txPool.foundRowsPool,
+		"readPool":      te.twoPC.readPool,
+	} {
+		if got, want := pool.MaxLifetime(), time.Minute; got != want {
+			t.Errorf("%s MaxLifetime: %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestTxEngineCloseProgress(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	ctx := context.Background()
+	config := tabletenv.DefaultQsConfig
+	config.TransactionCap = 10
+	config.TransactionTimeout = 10
+	config.TxShutDownGracePeriod = 1
+	te := NewTxEngine(nil, config)
+	te.InitDBConfig(dbcfgs)
+	defer func(saved time.Duration) { shutdownProgressInterval = saved }(shutdownProgressInterval)
+	shutdownProgressInterval = 10 * time.Millisecond
+
+	te.Open()
+	c, err := te.txPool.LocalBegin(ctx, false, querypb.ExecuteOptions_DEFAULT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Recycle()
+	done := make(chan struct{})
+	go func() {
+		te.Close(false)
+		close(done)
+	}()
+
+	// Wait for a progress update after the first one.
+	time.Sleep(50 * time.Millisecond)
+	if got, want := shutdownTransactions.Get(), int64(1); got != want {
+		t.Errorf("ShutdownTransactionsRemaining: %d, want %d", got, want)
+	}
+	if left := shutdownTimeLeft.Get(); left <= 0 || left >= time.Second {
+		t.Errorf("ShutdownGracePeriodLeft: %v, want between 0 and 1s", left)
+	}
+
+	if _, err := te.txPool.Get(c.TransactionID, "return"); err != nil {
+		t.Fatal(err)
+	}
+	te.txPool.LocalConclude(ctx, c)
+	<-done
+	if got := shutdownTransactions.Get(); got != 0 {
+		t.Errorf("ShutdownTransactionsRemaining after Close: %d, want 0", got)
+	}
+	if got := shutdownTimeLeft.Get(); got != 0 {
+		t.Errorf("ShutdownGracePeriodLeft after Close: %v, want 0", got)
+	}
+}
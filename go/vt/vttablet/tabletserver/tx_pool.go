@@ -19,6 +19,7 @@ package tabletserver
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -54,6 +55,10 @@ const (
 
 const txLogInterval = time.Duration(1 * time.Minute)
 
+// maxKilledTransactions is the number of manually killed transaction
+// ids the pool remembers to report them to the clients holding them.
+const maxKilledTransactions = 1000
+
 var (
 	txOnce  sync.Once
 	txStats = stats.NewTimings("Transactions")
@@ -85,6 +90,12 @@ type TxPool struct {
 	// Tracking culprits that cause tx pool full errors.
 	logMu   sync.Mutex
 	lastLog time.Time
+
+	// killed holds the ids of the last maxKilledTransactions
+	// manually killed transactions, in the order of killedIDs.
+	killedMu  sync.Mutex
+	killed    map[int64]bool
+	killedIDs []int64
 }
 
 // NewTxPool creates a new TxPool. It's not operational until it's Open'd.
@@ -105,6 +116,7 @@ func NewTxPool(
 		ticks:         timer.NewTimer(timeout / 10),
 		checker:       checker,
 		limiter:       limiter,
+		killed:        make(map[int64]bool),
 	}
 	txOnce.Do(func() {
 		// Careful: conns also exports name+"xxx" vars,
@@ -168,6 +180,86 @@ func (axp *TxPool) transactionKiller() {
 	}
 }
 
+// Kill rolls back the specified transaction by closing its connection.
+// It's used to manually terminate transactions that hold on to
+// connections. Transactions that are executing a statement can't
+// be killed.
+func (axp *TxPool) Kill(transactionID int64) error {
+	conn, err := axp.Get(transactionID, "for kill")
+	if err != nil {
+		return err
+	}
+	log.Warningf("killing transaction (requested manually): %s", conn.Format(nil))
+	tabletenv.KillStats.Add("ManualTransactions", 1)
+	axp.recordKilled(transactionID)
+	conn.Close()
+	conn.conclude(TxKill)
+	return nil
+}
+
+// recordKilled remembers that transactionID was killed manually.
+// Only the last maxKilledTransactions ids are kept.
+func (axp *TxPool) recordKilled(transactionID int64) {
+	axp.killedMu.Lock()
+	defer axp.killedMu.Unlock()
+	if len(axp.killedIDs) >= maxKilledTransactions {
+		delete(axp.killed, axp.killedIDs[0])
+		axp.killedIDs = axp.killedIDs[1:]
+	}
+	axp.killed[transactionID] = true
+	axp.killedIDs = append(axp.killedIDs, transactionID)
+}
+
+func (axp *TxPool) wasKilled(transactionID int64) bool {
+	axp.killedMu.Lock()
+	defer axp.killedMu.Unlock()
+	return axp.killed[transactionID]
+}
+
+// TxInfo describes an active transaction.
+type TxInfo struct {
+	TransactionID   int64
+	EffectiveCaller string
+	ImmediateCaller string
+	StartTime       time.Time
+	// LastUsed is the time the transaction was last returned to the
+	// pool. It's not meaningful if InUse is true.
+	LastUsed time.Time
+	InUse    bool
+	// Queries is only populated for transactions that are not in use.
+	Queries []string
+}
+
+// ActiveTransactions returns information about the transactions
+// that are currently open.
+func (axp *TxPool) ActiveTransactions() []*TxInfo {
+	var txs []*TxInfo
+	// Visit holds the pool lock: only copy the queries here,
+	// and redact them after it's released.
+	axp.activePool.Visit(func(val interface{}, inUse bool, timeUsed time.Time) {
+		conn := val.(*TxConnection)
+		info := &TxInfo{
+			TransactionID:   conn.TransactionID,
+			EffectiveCaller: callerid.GetPrincipal(conn.EffectiveCallerID),
+			ImmediateCaller: callerid.GetUsername(conn.ImmediateCallerID),
+			StartTime:       conn.StartTime,
+			LastUsed:        timeUsed,
+			InUse:           inUse,
+		}
+		if !inUse {
+			info.Queries = append([]string(nil), conn.Queries...)
+		}
+		txs = append(txs, info)
+	})
+	for _, info := range txs {
+		for i, query := range info.Queries {
+			info.Queries[i] = tabletenv.RedactSQL(query)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].StartTime.Before(txs[j].StartTime) })
+	return txs
+}
+
 // WaitForEmpty waits until all active transactions are completed.
 func (axp *TxPool) WaitForEmpty() {
 	axp.activePool.WaitForEmpty()
@@ -181,7 +273,11 @@ func (axp *TxPool) Begin(ctx context.Context, useFoundRows bool, txIsolation que
 	immediateCaller := callerid.ImmediateCallerIDFromContext(ctx)
 	effectiveCaller := callerid.EffectiveCallerIDFromContext(ctx)
 
-	if !axp.limiter.Get(immediateCaller, effectiveCaller) {
+	// Transactions started by vttablet itself, like message
+	// acks or 2pc resolution, are not subject to per-user limits.
+	// Otherwise, busy users could prevent them from running.
+	limited := !tabletenv.IsLocalContext(ctx)
+	if limited && !axp.limiter.Get(immediateCaller, effectiveCaller) {
 		return 0, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "per-user transaction pool connection limit exceeded")
 	}
 
@@ -194,7 +290,9 @@ func (axp *TxPool) Begin(ctx context.Context, useFoundRows bool, txIsolation que
 		if conn != nil {
 			conn.Recycle()
 		}
-		axp.limiter.Release(immediateCaller, effectiveCaller)
+		if limited {
+			axp.limiter.Release(immediateCaller, effectiveCaller)
+		}
 	}()
 
 	if useFoundRows {
@@ -225,16 +323,15 @@ func (axp *TxPool) Begin(ctx context.Context, useFoundRows bool, txIsolation que
 
 	beginSucceeded = true
 	transactionID := axp.lastID.Add(1)
-	axp.activePool.Register(
+	txConn := newTxConnection(
+		conn,
 		transactionID,
-		newTxConnection(
-			conn,
-			transactionID,
-			axp,
-			immediateCaller,
-			effectiveCaller,
-		),
+		axp,
+		immediateCaller,
+		effectiveCaller,
 	)
+	txConn.limited = limited
+	axp.activePool.Register(transactionID, txConn)
 	return transactionID, nil
 }
 
@@ -261,6 +358,9 @@ func (axp *TxPool) Rollback(ctx context.Context, transactionID int64) error {
 func (axp *TxPool) Get(transactionID int64, reason string) (*TxConnection, error) {
 	v, err := axp.activePool.Get(transactionID, reason)
 	if err != nil {
+		if axp.wasKilled(transactionID) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_CANCELED, "transaction %d: killed by an administrator", transactionID)
+		}
 		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction %d: %v", transactionID, err)
 	}
 	return v.(*TxConnection), nil
@@ -332,6 +432,12 @@ func (axp *TxPool) SetTimeout(timeout time.Duration) {
 	axp.ticks.SetInterval(timeout / 10)
 }
 
+// SetMaxLifetime sets the max lifetime of the pool connections.
+func (axp *TxPool) SetMaxLifetime(maxLifetime time.Duration) {
+	axp.conns.SetMaxLifetime(maxLifetime)
+	axp.foundRowsPool.SetMaxLifetime(maxLifetime)
+}
+
 // TxConnection is meant for executing transactions. It can return itself to
 // the tx pool correctly. It also does not retry statements if there
 // are failures.
@@ -348,6 +454,8 @@ type TxConnection struct {
 	LogToFile         sync2.AtomicInt32
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
+	// limited is true if the transaction holds a slot in the limiter.
+	limited bool
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID) *TxConnection {
@@ -411,7 +519,9 @@ func (txc *TxConnection) conclude(conclusion string) {
 	txc.pool.activePool.Unregister(txc.TransactionID)
 	txc.DBConn.Recycle()
 	txc.DBConn = nil
-	txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
+	if txc.limited {
+		txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
+	}
 	txc.log(conclusion)
 }
 
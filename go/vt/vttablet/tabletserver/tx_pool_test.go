@@ -101,6 +101,23 @@ func TestTxPoolRollbackNonBusy(t *testing.T) {
 	}
 }
 
+func TestTxPoolRecordKilled(t *testing.T) {
+	txPool := newTxPool()
+	for id := int64(0); id <= maxKilledTransactions; id++ {
+		txPool.recordKilled(id)
+	}
+	// The oldest id was forgotten.
+	if txPool.wasKilled(0) {
+		t.Error("wasKilled(0): true, want false")
+	}
+	if !txPool.wasKilled(1) || !txPool.wasKilled(maxKilledTransactions) {
+		t.Error("wasKilled: false for a recent kill, want true")
+	}
+	if got := len(txPool.killed); got != maxKilledTransactions {
+		t.Errorf("killed ids: %d, want %d", got, maxKilledTransactions)
+	}
+}
+
 func TestTxPoolTransactionKiller(t *testing.T) {
 	sql := "alter table test_table add test_column int"
 	db := fakesqldb.New(t)
@@ -479,6 +496,46 @@ func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
 	}
 }
 
+func TestTxPoolLimiterExemptsLocalContext(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	// Allows a single transaction per user.
+	limiter := txlimiter.New(10, 0.1, true, false, true, false, false, false, nil)
+	txPool := NewTxPool(
+		fmt.Sprintf("TestTransactionPool-%d", rand.Int63()),
+		10,
+		10,
+		30*time.Second,
+		30*time.Second,
+		DummyChecker,
+		limiter,
+	)
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+
+	ctx := context.Background()
+	if _, err := txPool.Begin(ctx, false, querypb.ExecuteOptions_DEFAULT); err != nil {
+		t.Fatal(err)
+	}
+	_, err := txPool.Begin(ctx, false, querypb.ExecuteOptions_DEFAULT)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Begin over limit: %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	localCtx := tabletenv.LocalContext()
+	for i := 0; i < 2; i++ {
+		conn, err := txPool.LocalBegin(localCtx, false, querypb.ExecuteOptions_DEFAULT)
+		if err != nil {
+			t.Fatalf("LocalBegin with local context: %v", err)
+		}
+		txPool.LocalConclude(localCtx, conn)
+	}
+	txPool.RollbackNonBusy(ctx)
+}
+
 func newTxPool() *TxPool {
 	randID := rand.Int63()
 	poolName := fmt.Sprintf("TestTransactionPool-%d", randID)
@@ -51,20 +51,27 @@ type TxLimiter interface {
 // byXXX: whether given field from immediate/effective caller id should be taken
 // into account when deciding "user" identity for purposes of transaction
 // limiting.
-func New(slotCount int, maxPerUser float64, enabled, dryRun, byUsername, byPrincipal, byComponent, bySubcomponent bool) TxLimiter {
+// overrides: fraction of the pool that may be taken by specific users,
+// keyed by the same identity used for limiting.
+func New(slotCount int, maxPerUser float64, enabled, dryRun, byUsername, byPrincipal, byComponent, bySubcomponent bool, overrides map[string]float64) TxLimiter {
 	if !enabled && !dryRun {
 		return &TxAllowAll{}
 	}
 
+	maxPerUserOverrides := make(map[string]int64, len(overrides))
+	for key, fraction := range overrides {
+		maxPerUserOverrides[key] = int64(float64(slotCount) * fraction)
+	}
 	return &Impl{
-		maxPerUser:      int64(float64(slotCount) * maxPerUser),
-		dryRun:          dryRun,
-		byUsername:      byUsername,
-		byPrincipal:     byPrincipal,
-		byComponent:     byComponent,
-		bySubcomponent:  bySubcomponent,
-		byEffectiveUser: byPrincipal || byComponent || bySubcomponent,
-		usageMap:        make(map[string]int64),
+		maxPerUser:          int64(float64(slotCount) * maxPerUser),
+		maxPerUserOverrides: maxPerUserOverrides,
+		dryRun:              dryRun,
+		byUsername:          byUsername,
+		byPrincipal:         byPrincipal,
+		byComponent:         byComponent,
+		bySubcomponent:      bySubcomponent,
+		byEffectiveUser:     byPrincipal || byComponent || bySubcomponent,
+		usageMap:            make(map[string]int64),
 	}
 }
 
@@ -88,9 +95,10 @@ func (txa *TxAllowAll) Release(immediate *querypb.VTGateCallerID, effective *vtr
 // concurrently.
 // Implements TxLimiter.
 type Impl struct {
-	maxPerUser int64
-	usageMap   map[string]int64
-	mu         sync.Mutex
+	maxPerUser          int64
+	maxPerUserOverrides map[string]int64
+	usageMap            map[string]int64
+	mu                  sync.Mutex
 
 	dryRun          bool
 	byUsername      bool
@@ -110,8 +118,12 @@ func (txl *Impl) Get(immediate *querypb.VTGateCallerID, effective *vtrpcpb.Calle
 	txl.mu.Lock()
 	defer txl.mu.Unlock()
 
+	maxPerUser, ok := txl.maxPerUserOverrides[key]
+	if !ok {
+		maxPerUser = txl.maxPerUser
+	}
 	usage := txl.usageMap[key]
-	if usage < txl.maxPerUser {
+	if usage < maxPerUser {
 		txl.usageMap[key] = usage + 1
 		return true
 	}
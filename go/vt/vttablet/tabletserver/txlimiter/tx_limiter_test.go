@@ -21,7 +21,7 @@ func createCallers(username, principal, component, subcomponent string) (*queryp
 }
 
 func TestTxLimiter_DisabledAllowsAll(t *testing.T) {
-	limiter := New(10, 0.1, false, false, false, false, false, false)
+	limiter := New(10, 0.1, false, false, false, false, false, false, nil)
 	im, ef := createCallers("", "", "", "")
 	for i := 0; i < 5; i++ {
 		if got, want := limiter.Get(im, ef), true; got != want {
@@ -35,7 +35,7 @@ func TestTxLimiter_LimitsOnlyOffendingUser(t *testing.T) {
 	resetVariables()
 
 	// This should allow 3 slots to all users
-	newlimiter := New(10, 0.3, true, false, true, false, false, false)
+	newlimiter := New(10, 0.3, true, false, true, false, false, false, nil)
 	limiter, ok := newlimiter.(*Impl)
 	if !ok {
 		t.Fatalf("New returned limiter of unexpected type: got %T, want %T", newlimiter, limiter)
@@ -92,7 +92,7 @@ func TestTxLimiterDryRun(t *testing.T) {
 	resetVariables()
 
 	// This should allow 3 slots to all users
-	newlimiter := New(10, 0.3, true, true, true, false, false, false)
+	newlimiter := New(10, 0.3, true, true, true, false, false, false, nil)
 	limiter, ok := newlimiter.(*Impl)
 	if !ok {
 		t.Fatalf("New returned limiter of unexpected type: got %T, want %T", newlimiter, limiter)
@@ -119,3 +119,34 @@ func TestTxLimiterDryRun(t *testing.T) {
 		t.Errorf("RejectionsDryRun count for %s: got %d, want %d", key, got, want)
 	}
 }
+
+func TestTxLimiterOverrides(t *testing.T) {
+	resetVariables()
+
+	// Default of 3 slots, except for user2 which gets 5.
+	newlimiter := New(10, 0.3, true, false, true, false, false, false, map[string]float64{"user2": 0.5})
+	limiter, ok := newlimiter.(*Impl)
+	if !ok {
+		t.Fatalf("New returned limiter of unexpected type: got %T, want %T", newlimiter, limiter)
+	}
+	im1, ef1 := createCallers("user1", "", "", "")
+	im2, ef2 := createCallers("user2", "", "", "")
+
+	for i := 0; i < 3; i++ {
+		if got, want := limiter.Get(im1, ef1), true; got != want {
+			t.Errorf("Transaction number %d, Get(im1, ef1): got %v, want %v", i, got, want)
+		}
+	}
+	if got, want := limiter.Get(im1, ef1), false; got != want {
+		t.Errorf("Get(im1, ef1) after using up all allowed attempts: got %v, want %v", got, want)
+	}
+
+	for i := 0; i < 5; i++ {
+		if got, want := limiter.Get(im2, ef2), true; got != want {
+			t.Errorf("Transaction number %d, Get(im2, ef2): got %v, want %v", i, got, want)
+		}
+	}
+	if got, want := limiter.Get(im2, ef2), false; got != want {
+		t.Errorf("Get(im2, ef2) after using up all allowed attempts: got %v, want %v", got, want)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/youtube/vitess/go/sync2"
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/vterrors"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
@@ -45,6 +46,11 @@ var (
 	// transactions would have been queued.
 	// The key of the map is the table name of the query.
 	waitsDryRun = stats.NewCounters("TxSerializerWaitsDryRun")
+	// waiting is a gauge of how many transactions are currently blocked in
+	// a queue because another transaction for the same row (range) is in
+	// flight.
+	// The key of the map is the table name of the query.
+	waiting = stats.NewCounters("TxSerializerWaiting")
 
 	// queueExceeded counts per table how many transactions were rejected because
 	// the max queue size per row (range) was exceeded.
@@ -55,8 +61,27 @@ var (
 	// globalQueueExceeded is the same as queueExceeded but for the global queue.
 	globalQueueExceeded       = stats.NewInt("TxSerializerGlobalQueueExceeded")
 	globalQueueExceededDryRun = stats.NewInt("TxSerializerGlobalQueueExceededDryRun")
+	// queueTimeouts counts per table how many transactions were rejected
+	// because they waited too long in the queue. See RecordQueueTimeout().
+	queueTimeouts = stats.NewCounters("TxSerializerQueueTimeouts")
 )
 
+func init() {
+	tabletenv.RegisterTableStats(deleteTableStats)
+}
+
+// deleteTableStats removes the stats of a dropped table.
+func deleteTableStats(table string) {
+	for _, c := range []*stats.Counters{waits, waitsDryRun, queueExceeded, queueExceededDryRun, queueTimeouts} {
+		c.Delete(table)
+	}
+	// Transactions may still be queued for the dropped table. Their
+	// decrements would make the gauge negative: keep it until they're gone.
+	if waiting.Counts()[table] == 0 {
+		waiting.Delete(table)
+	}
+}
+
 // TxSerializer serializes incoming transactions which target the same row range
 // i.e. table name and WHERE clause are identical.
 // Additional transactions are queued and woken up in arrival order.
@@ -211,6 +236,8 @@ func (t *TxSerializer) lockLocked(ctx context.Context, key, table string) (bool,
 
 	// Blocking wait for the next available slot.
 	waits.Add(table, 1)
+	waiting.Add(table, 1)
+	defer waiting.Add(table, -1)
 	select {
 	case q.availableSlots <- struct{}{}:
 		return true, nil
@@ -267,6 +294,12 @@ func (t *TxSerializer) unlockLocked(key string, returnSlot bool) {
 	<-q.availableSlots
 }
 
+// RecordQueueTimeout records that a transaction for "table" was rejected
+// because its caller gave up waiting in the queue.
+func (t *TxSerializer) RecordQueueTimeout(table string) {
+	queueTimeouts.Add(table, 1)
+}
+
 // Pending returns the number of queued transactions (including the ones which
 // are currently in flight.)
 func (t *TxSerializer) Pending(key string) int {
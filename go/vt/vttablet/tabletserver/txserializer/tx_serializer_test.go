@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -27,8 +28,10 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/streamlog"
 	"github.com/youtube/vitess/go/vt/vterrors"
+	"github.com/youtube/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
@@ -38,6 +41,8 @@ func resetVariables() {
 	waitsDryRun.Reset()
 	queueExceeded.Reset()
 	queueExceededDryRun.Reset()
+	waiting.Reset()
+	queueTimeouts.Reset()
 	globalQueueExceeded.Set(0)
 	globalQueueExceededDryRun.Set(0)
 }
@@ -208,6 +213,9 @@ func TestTxSerializer_ConcurrentTransactions(t *testing.T) {
 	if err := waitForPending(txs, "t1 where1", 3); err != nil {
 		t.Fatal(err)
 	}
+	if err := waitForWaiting("t1", 1); err != nil {
+		t.Fatal(err)
+	}
 	// Finish tx2 before tx1 to test that the "finish-order" does not matter.
 	// Unblocks tx3.
 	done2()
@@ -228,6 +236,24 @@ func TestTxSerializer_ConcurrentTransactions(t *testing.T) {
 	if got, want := waits.Counts()["t1"], int64(1); got != want {
 		t.Fatalf("variable not incremented: got = %v, want = %v", got, want)
 	}
+	if got, want := waiting.Counts()["t1"], int64(0); got != want {
+		t.Fatalf("waiting transactions not decremented: got = %v, want = %v", got, want)
+	}
+}
+
+func waitForWaiting(table string, i int64) error {
+	start := time.Now()
+	for {
+		got, want := waiting.Counts()[table], i
+		if got == want {
+			return nil
+		}
+
+		if time.Since(start) > 2*time.Second {
+			return fmt.Errorf("wait for TxSerializerWaiting = %d timed out: got = %v, want = %v", i, got, want)
+		}
+		time.Sleep(1 * time.Millisecond)
+	}
 }
 
 func waitForPending(txs *TxSerializer, key string, i int) error {
@@ -373,6 +399,10 @@ func TestTxSerializerCancel(t *testing.T) {
 	if got, want := waits.Counts()["t1"], int64(2); got != want {
 		t.Fatalf("variable not incremented: got = %v, want = %v", got, want)
 	}
+	// The canceled tx3 is no longer counted as waiting.
+	if got, want := waiting.Counts()["t1"], int64(0); got != want {
+		t.Fatalf("waiting transactions not decremented: got = %v, want = %v", got, want)
+	}
 }
 
 // TestTxSerializerDryRun verifies that the dry-run mode does not serialize
@@ -503,3 +533,32 @@ func BenchmarkTxSerializer_NoHotRow(b *testing.B) {
 		done()
 	}
 }
+
+func TestTxSerializerDeleteTableStats(t *testing.T) {
+	resetVariables()
+	for _, c := range []*stats.Counters{waits, waitsDryRun, queueExceeded, queueExceededDryRun, queueTimeouts} {
+		c.Add("t1", 1)
+		c.Add("t2", 1)
+	}
+	waiting.Add("t1", 1)
+	waiting.Add("t2", 0)
+
+	tabletenv.DeleteTableStats("t2")
+	for _, c := range []*stats.Counters{waits, waitsDryRun, queueExceeded, queueExceededDryRun, queueTimeouts} {
+		if got, want := c.Counts(), map[string]int64{"t1": 1}; !reflect.DeepEqual(got, want) {
+			t.Errorf("after dropping t2: %v, want %v", got, want)
+		}
+	}
+	if got, want := waiting.Counts(), map[string]int64{"t1": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TxSerializerWaiting after dropping t2: %v, want %v", got, want)
+	}
+
+	// A transaction still waits for t1: its gauge is kept.
+	tabletenv.DeleteTableStats("t1")
+	if got, want := waiting.Counts(), map[string]int64{"t1": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TxSerializerWaiting after dropping t1: %v, want %v", got, want)
+	}
+	if got := waits.Counts(); len(got) != 0 {
+		t.Errorf("TxSerializerWaits after dropping t1: %v, want empty", got)
+	}
+}
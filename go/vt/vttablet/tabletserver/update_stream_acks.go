@@ -0,0 +1,154 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+
+	"github.com/youtube/vitess/go/acl"
+	"github.com/youtube/vitess/go/vt/logz"
+)
+
+var (
+	updateStreamAcksHeader = []byte(`<thead>
+		<tr>
+			<th>Consumer</th>
+			<th>Position</th>
+			<th>Last Ack</th>
+			<th>Age</th>
+		</tr>
+        </thead>
+	`)
+	updateStreamAcksFuncMap = template.FuncMap{
+		"stampMicro": func(t time.Time) string { return t.Format(time.StampMicro) },
+		"since":      func(t time.Time) string { return time.Now().Sub(t).String() },
+	}
+	updateStreamAcksTmpl = template.Must(template.New("updateStreamAcks").Funcs(updateStreamAcksFuncMap).Parse(`
+		<tr>
+			<td>{{.Consumer}}</td>
+			<td>{{.Position}}</td>
+			<td>{{.Time | stampMicro}}</td>
+			<td>{{.Time | since}}</td>
+		</tr>
+	`))
+)
+
+// UpdateStreamAckInfo is the last position an UpdateStream consumer
+// reported it had applied.
+type UpdateStreamAckInfo struct {
+	Consumer string
+	Position string
+	Time     time.Time
+}
+
+// updateStreamAcks keeps the last ack of every UpdateStream consumer.
+// Consumers that never ack don't show up.
+type updateStreamAcks struct {
+	mu   sync.Mutex
+	acks map[string]UpdateStreamAckInfo
+}
+
+func newUpdateStreamAcks() *updateStreamAcks {
+	return &updateStreamAcks{acks: make(map[string]UpdateStreamAckInfo)}
+}
+
+// Record replaces the ack of consumer.
+func (ua *updateStreamAcks) Record(consumer, position string, now time.Time) {
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	ua.acks[consumer] = UpdateStreamAckInfo{
+		Consumer: consumer,
+		Position: position,
+		Time:     now,
+	}
+}
+
+// Acks returns the acks sorted by consumer.
+func (ua *updateStreamAcks) Acks() []UpdateStreamAckInfo {
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	acks := make([]UpdateStreamAckInfo, 0, len(ua.acks))
+	for _, ack := range ua.acks {
+		acks = append(acks, ack)
+	}
+	sort.Slice(acks, func(i, j int) bool { return acks[i].Consumer < acks[j].Consumer })
+	return acks
+}
+
+// Positions returns the acked position of every consumer.
+func (ua *updateStreamAcks) Positions() map[string]string {
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	positions := make(map[string]string, len(ua.acks))
+	for consumer, ack := range ua.acks {
+		positions[consumer] = ack.Position
+	}
+	return positions
+}
+
+// SecondsSinceAck returns how long ago every consumer last acked.
+func (ua *updateStreamAcks) SecondsSinceAck() map[string]int64 {
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	now := time.Now()
+	ages := make(map[string]int64, len(ua.acks))
+	for consumer, ack := range ua.acks {
+		ages[consumer] = int64(now.Sub(ack.Time).Seconds())
+	}
+	return ages
+}
+
+// updateStreamAcksHandler lists the positions acked by UpdateStream
+// consumers.
+// Endpoint: /debug/update_stream_acks?format=json
+func updateStreamAcksHandler(acks *updateStreamAcks, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusInternalServerError)
+		return
+	}
+	infos := acks.Acks()
+	if r.FormValue("format") == "json" {
+		js, err := json.Marshal(infos)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(js)
+		return
+	}
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	w.Write(updateStreamAcksHeader)
+	for _, info := range infos {
+		if err := updateStreamAcksTmpl.Execute(w, info); err != nil {
+			log.Errorf("update stream acks: couldn't execute template: %v", err)
+		}
+	}
+}
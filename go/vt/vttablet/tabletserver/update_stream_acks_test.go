@@ -0,0 +1,64 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUpdateStreamAcks(t *testing.T) {
+	acks := newUpdateStreamAcks()
+	now := time.Now()
+	acks.Record("b", "MariaDB/0-1-2", now)
+	acks.Record("a", "MariaDB/0-1-1", now)
+	acks.Record("b", "MariaDB/0-1-3", now)
+
+	want := map[string]string{
+		"a": "MariaDB/0-1-1",
+		"b": "MariaDB/0-1-3",
+	}
+	if got := acks.Positions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Positions: %v, want %v", got, want)
+	}
+	if got := acks.SecondsSinceAck(); len(got) != 2 || got["a"] != 0 || got["b"] != 0 {
+		t.Errorf("SecondsSinceAck: %v, want 0 for a and b", got)
+	}
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/update_stream_acks?format=json", nil)
+	updateStreamAcksHandler(acks, resp, req)
+	var infos []UpdateStreamAckInfo
+	if err := json.Unmarshal(resp.Body.Bytes(), &infos); err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 || infos[0].Consumer != "a" || infos[1].Consumer != "b" || infos[1].Position != "MariaDB/0-1-3" {
+		t.Errorf("acks: %s, want a at MariaDB/0-1-1 and b at MariaDB/0-1-3", resp.Body.String())
+	}
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/update_stream_acks", nil)
+	updateStreamAcksHandler(acks, resp, req)
+	if !strings.Contains(resp.Body.String(), "<td>MariaDB/0-1-3</td>") {
+		t.Errorf("acks page is missing the position of b: %s", resp.Body.String())
+	}
+}
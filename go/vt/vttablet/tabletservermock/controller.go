@@ -156,6 +156,11 @@ func (tqsc *Controller) ReloadSchema(ctx context.Context) error {
 	return nil
 }
 
+// ReloadSchemaTable is part of the tabletserver.Controller interface
+func (tqsc *Controller) ReloadSchemaTable(ctx context.Context, tableName string) (int64, error) {
+	return 0, nil
+}
+
 //ClearQueryPlanCache is part of the tabletserver.Controller interface
 func (tqsc *Controller) ClearQueryPlanCache() {
 }
@@ -794,7 +794,7 @@ class TestTabletManager(unittest.TestCase):
         timeout = utils.wait_step('query rule in place', timeout)
       except Exception as e:
         print e
-        expected = ('disallowed due to rule: disallow select'
+        expected = ('disallowed due to rule rule1: disallow select'
                     ' on table vt_select_test')
         self.assertIn(expected, str(e))
         break
@@ -361,7 +361,8 @@ msg varchar(64)
                                      'select count(1) from %s' % table],
                                     expect_fail=True)
         self.assertIn(
-            'disallowed due to rule: enforce blacklisted tables',
+            'disallowed due to rule blacklisted_table:'
+            ' enforce blacklisted tables',
             stderr)
       else:
         # table is not blacklisted, should just work